@@ -32,6 +32,15 @@ func (g Generator) Generate() error {
 		return err
 	}
 
+	// types directory is optional
+	typeFiles := make([]string, 0)
+	if _, err := os.Stat("types"); err == nil {
+		typeFiles, err = listYamlFiles("types")
+		if err != nil {
+			return err
+		}
+	}
+
 	tableSpecs, err := createTableSpecs(tableFiles, defaultSchema)
 	if err != nil {
 		return err
@@ -42,12 +51,17 @@ func (g Generator) Generate() error {
 		return err
 	}
 
+	typeSpecs, err := createTypeSpecs(typeFiles, defaultSchema)
+	if err != nil {
+		return err
+	}
+
 	rootDir := "."
 	if g.RootDir != "" {
 		rootDir = g.RootDir
 	}
 
-	i, err := interpolation.NewInterpolator(g.BaseImportPath, rootDir, defaultSchema, tableSpecs, viewSpecs)
+	i, err := interpolation.NewInterpolator(g.BaseImportPath, rootDir, defaultSchema, tableSpecs, viewSpecs, typeSpecs)
 	if err != nil {
 		return err
 	}
@@ -74,6 +88,11 @@ func (g Generator) Generate() error {
 		return err
 	}
 
+	err = g.generateTypes(i.TypesData)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -120,6 +139,18 @@ func createViewSpecs(viewFiles []string, defaultSchema string) ([]*schema.View,
 	return specs, nil
 }
 
+func createTypeSpecs(typeFiles []string, defaultSchema string) ([]*schema.Type, error) {
+	specs := make([]*schema.Type, len(typeFiles))
+	for i, specFile := range typeFiles {
+		s, err := schema.NewType(specFile, defaultSchema)
+		if err != nil {
+			return nil, err
+		}
+		specs[i] = s
+	}
+	return specs, nil
+}
+
 func (g Generator) generateCommons(itp *interpolation.Interpolator) error {
 	tmplNames := []string{
 		"runner.go",
@@ -151,7 +182,7 @@ func (g Generator) generateTables(tds []*interpolation.TableData) error {
 
 		// generate source codes
 		tmplNames := []string{
-			"csvReader.go",
+			"rowReader.go",
 			"fieldProvider.go",
 			"converter.go",
 			"computer.go",
@@ -187,3 +218,20 @@ func (g Generator) generateViews(vs []*interpolation.ViewData) error {
 	}
 	return nil
 }
+
+func (g Generator) generateTypes(ts []*interpolation.TypeData) error {
+	// create directory for package
+	pkgDir := filepath.Join(g.RootDir, "internal", "typesql")
+	err := os.MkdirAll(pkgDir, 0777)
+	if err != nil {
+		return err
+	}
+
+	err = execTemplate(
+		filepath.Join(pkgDir, generatedFilename("type.go")),
+		"type.go", ts)
+	if err != nil {
+		return err
+	}
+	return nil
+}
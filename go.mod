@@ -3,10 +3,16 @@ module github.com/frm-adiputra/csv2postgres
 go 1.14
 
 require (
+	github.com/360EntSecGroup-Skylar/excelize/v2 v2.3.2
 	github.com/goccy/go-yaml v1.4.3
 	github.com/iancoleman/strcase v0.0.0-20191112232945-16388991a334
 	github.com/joho/sqltocsv v0.0.0-20190824231449-5650f27fd5b6
+	github.com/klauspost/compress v1.11.13
 	github.com/lib/pq v1.8.0
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/urfave/cli/v2 v2.2.0
+	github.com/xitongsys/parquet-go v1.5.5-0.20201110004701-b09c49d6d457
+	github.com/xitongsys/parquet-go-source v0.0.0-20200817004010-026bad9b25d0
 	github.com/yourbasic/graph v0.0.0-20170921192928-40eb135c0b26
+	golang.org/x/text v0.3.3
 )
@@ -2,14 +2,15 @@ package box
 
 // Code generated by go generate; DO NOT EDIT.
 func init() {
-	box.Add("/computer.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 47, 47, 32, 67, 111, 109, 112, 117, 116, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 111, 109, 112, 117, 116, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 67, 111, 109, 112, 117, 116, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 32, 102, 117, 110, 99, 32, 40, 123, 123, 46, 65, 114, 103, 117, 109, 101, 110, 116, 84, 121, 112, 101, 125, 125, 41, 32, 40, 123, 123, 46, 82, 101, 116, 117, 114, 110, 84, 121, 112, 101, 125, 125, 44, 32, 101, 114, 114, 111, 114, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 10, 47, 47, 32, 67, 111, 109, 112, 117, 116, 101, 32, 99, 111, 109, 112, 117, 116, 101, 32, 102, 105, 101, 108, 100, 39, 115, 32, 118, 97, 108, 117, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 99, 32, 67, 111, 109, 112, 117, 116, 101, 114, 41, 32, 67, 111, 109, 112, 117, 116, 101, 40, 102, 105, 101, 108, 100, 115, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 10, 32, 32, 32, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 99, 46, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 123, 123, 46, 71, 111, 84, 121, 112, 101, 125, 125, 41, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 99, 46, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 40, 102, 105, 101, 108, 100, 115, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 105, 101, 108, 100, 115, 44, 32, 110, 105, 108, 10, 125, 10})
-	box.Add("/converter.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 83, 116, 114, 99, 111, 110, 118, 80, 107, 103, 125, 125, 10, 9, 34, 115, 116, 114, 99, 111, 110, 118, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 84, 105, 109, 101, 80, 107, 103, 125, 125, 10, 9, 34, 116, 105, 109, 101, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 117, 116, 105, 108, 115, 34, 10, 41, 10, 10, 47, 47, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 125, 10, 10, 47, 47, 32, 67, 111, 110, 118, 101, 114, 116, 32, 99, 111, 110, 118, 101, 114, 116, 115, 32, 102, 105, 101, 108, 100, 39, 115, 32, 118, 97, 108, 117, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 99, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 41, 32, 67, 111, 110, 118, 101, 114, 116, 40, 102, 105, 101, 108, 100, 115, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 98, 111, 111, 108, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 66, 111, 111, 108, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 102, 108, 111, 97, 116, 54, 52, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 70, 108, 111, 97, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 54, 52, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 70, 108, 111, 97, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 70, 108, 111, 97, 116, 54, 52, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 105, 110, 116, 51, 50, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 73, 110, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 49, 48, 44, 32, 51, 50, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 73, 110, 116, 51, 50, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 73, 110, 116, 51, 50, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 105, 110, 116, 54, 52, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 73, 110, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 49, 48, 44, 32, 54, 52, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 73, 110, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 73, 110, 116, 54, 52, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 116, 114, 105, 110, 103, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 58, 61, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 83, 116, 114, 105, 110, 103, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 83, 116, 114, 105, 110, 103, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 116, 105, 109, 101, 46, 84, 105, 109, 101, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 116, 105, 109, 101, 46, 80, 97, 114, 115, 101, 40, 34, 123, 123, 46, 84, 105, 109, 101, 70, 111, 114, 109, 97, 116, 125, 125, 34, 44, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 84, 105, 109, 101, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 84, 105, 109, 101, 40, 34, 123, 123, 46, 84, 105, 109, 101, 70, 111, 114, 109, 97, 116, 125, 125, 34, 44, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 105, 101, 108, 100, 115, 44, 32, 110, 105, 108, 10, 125, 10})
-	box.Add("/csvReader.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 10, 47, 47, 32, 78, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 32, 99, 114, 101, 97, 116, 101, 115, 32, 97, 32, 110, 101, 119, 32, 67, 83, 86, 82, 101, 97, 100, 101, 114, 46, 10, 102, 117, 110, 99, 32, 78, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 40, 41, 32, 42, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 83, 86, 82, 101, 97, 100, 101, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 83, 86, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 34, 123, 123, 46, 68, 97, 116, 97, 83, 111, 117, 114, 99, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 83, 101, 112, 97, 114, 97, 116, 111, 114, 58, 32, 39, 123, 123, 46, 67, 83, 86, 83, 101, 112, 97, 114, 97, 116, 111, 114, 125, 125, 39, 44, 10, 32, 32, 32, 32, 125, 10, 125, 10})
-	box.Add("/dbSync.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 100, 97, 116, 97, 98, 97, 115, 101, 47, 115, 113, 108, 34, 10, 32, 32, 32, 32, 34, 102, 109, 116, 34, 10, 32, 32, 32, 32, 34, 105, 111, 34, 10, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 108, 105, 98, 47, 112, 113, 34, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 67, 111, 109, 112, 117, 116, 101, 100, 125, 125, 10, 9, 34, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 80, 97, 99, 107, 97, 103, 101, 125, 125, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 47, 47, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 46, 10, 116, 121, 112, 101, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 32, 32, 32, 32, 110, 97, 109, 101, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 67, 114, 101, 97, 116, 101, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 68, 101, 108, 101, 116, 101, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 68, 114, 111, 112, 32, 32, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 32, 32, 32, 32, 100, 101, 112, 101, 110, 100, 115, 79, 110, 32, 32, 32, 32, 91, 93, 115, 116, 114, 105, 110, 103, 10, 32, 32, 32, 32, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 32, 42, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 10, 125, 10, 10, 47, 47, 32, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 99, 114, 101, 97, 116, 101, 115, 32, 97, 32, 110, 101, 119, 32, 105, 110, 115, 116, 97, 110, 99, 101, 46, 10, 102, 117, 110, 99, 32, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 40, 41, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 123, 10, 32, 32, 32, 32, 114, 32, 58, 61, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 78, 97, 109, 101, 58, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 82, 111, 119, 82, 101, 97, 100, 101, 114, 58, 32, 32, 32, 32, 32, 78, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 40, 41, 44, 10, 9, 9, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 58, 32, 38, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 123, 125, 44, 10, 9, 9, 67, 111, 110, 118, 101, 114, 116, 101, 114, 58, 32, 32, 32, 32, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 123, 125, 44, 10, 9, 9, 67, 111, 109, 112, 117, 116, 101, 114, 58, 32, 32, 32, 32, 32, 32, 67, 111, 109, 112, 117, 116, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 58, 32, 123, 123, 36, 46, 67, 111, 109, 112, 117, 116, 101, 80, 107, 103, 86, 97, 114, 125, 125, 46, 123, 123, 46, 78, 97, 109, 101, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 9, 9, 86, 97, 108, 105, 100, 97, 116, 111, 114, 58, 32, 32, 32, 32, 32, 86, 97, 108, 105, 100, 97, 116, 111, 114, 123, 125, 44, 10, 9, 125, 10, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 110, 97, 109, 101, 58, 32, 34, 123, 123, 46, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 100, 101, 112, 101, 110, 100, 115, 79, 110, 58, 32, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 101, 112, 101, 110, 100, 115, 79, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 96, 123, 123, 46, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 58, 32, 114, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 68, 101, 108, 101, 116, 101, 58, 32, 96, 68, 69, 76, 69, 84, 69, 32, 70, 82, 79, 77, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 68, 114, 111, 112, 58, 32, 96, 68, 82, 79, 80, 32, 84, 65, 66, 76, 69, 32, 73, 70, 32, 69, 88, 73, 83, 84, 83, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 67, 114, 101, 97, 116, 101, 58, 32, 96, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 67, 82, 69, 65, 84, 69, 32, 84, 65, 66, 76, 69, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 32, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 58, 61, 32, 116, 114, 117, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 36, 102, 105, 114, 115, 116, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 61, 32, 102, 97, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 44, 123, 123, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 32, 123, 123, 46, 84, 121, 112, 101, 125, 125, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 32, 78, 79, 84, 32, 78, 85, 76, 76, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 32, 123, 123, 46, 84, 121, 112, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 110, 115, 116, 114, 97, 105, 110, 116, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 46, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 58, 61, 32, 102, 97, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 96, 44, 10, 32, 32, 32, 32, 125, 10, 125, 10, 10, 47, 47, 32, 78, 97, 109, 101, 32, 114, 101, 116, 117, 114, 110, 115, 32, 116, 104, 101, 32, 116, 97, 98, 108, 101, 39, 115, 32, 110, 97, 109, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 78, 97, 109, 101, 40, 41, 32, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 110, 97, 109, 101, 10, 125, 10, 10, 47, 47, 32, 82, 111, 119, 67, 111, 117, 110, 116, 32, 114, 101, 116, 117, 114, 110, 115, 32, 110, 117, 109, 98, 101, 114, 32, 111, 102, 32, 114, 111, 119, 115, 32, 116, 104, 97, 116, 32, 105, 115, 32, 102, 105, 108, 108, 101, 100, 46, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 32, 105, 110, 116, 54, 52, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 10, 125, 10, 10, 47, 47, 32, 68, 101, 112, 101, 110, 100, 115, 79, 110, 32, 114, 101, 116, 117, 114, 110, 115, 32, 111, 116, 104, 101, 114, 32, 116, 97, 98, 108, 101, 115, 32, 116, 104, 97, 116, 32, 116, 104, 105, 115, 32, 116, 97, 98, 108, 101, 32, 100, 101, 112, 101, 110, 100, 115, 32, 111, 110, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 101, 112, 101, 110, 100, 115, 79, 110, 40, 41, 32, 91, 93, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 100, 101, 112, 101, 110, 100, 115, 79, 110, 10, 125, 10, 10, 47, 47, 32, 67, 114, 101, 97, 116, 101, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 67, 114, 101, 97, 116, 101, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 67, 114, 101, 97, 116, 101, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 68, 101, 108, 101, 116, 101, 32, 97, 108, 108, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 101, 108, 101, 116, 101, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 68, 101, 108, 101, 116, 101, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 68, 114, 111, 112, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 114, 111, 112, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 68, 114, 111, 112, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 70, 105, 108, 108, 32, 114, 111, 119, 115, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 70, 105, 108, 108, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 101, 114, 114, 32, 58, 61, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 79, 112, 101, 110, 40, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 9, 100, 101, 102, 101, 114, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 9, 116, 120, 110, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 66, 101, 103, 105, 110, 40, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 32, 32, 32, 32, 115, 116, 109, 116, 44, 32, 101, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 80, 114, 101, 112, 97, 114, 101, 40, 112, 113, 46, 123, 123, 105, 102, 32, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 67, 111, 112, 121, 73, 110, 83, 99, 104, 101, 109, 97, 123, 123, 101, 108, 115, 101, 125, 125, 67, 111, 112, 121, 73, 110, 123, 123, 101, 110, 100, 125, 125, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 41, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 102, 111, 114, 32, 123, 10, 9, 9, 114, 101, 99, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 101, 97, 100, 82, 101, 99, 111, 114, 100, 40, 41, 10, 9, 9, 105, 102, 32, 101, 114, 114, 32, 61, 61, 32, 105, 111, 46, 69, 79, 70, 32, 123, 10, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 125, 10, 9, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 9, 9, 9, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 44, 10, 9, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 9, 125, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 58, 32, 37, 119, 34, 44, 10, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 44, 32, 101, 114, 114, 41, 10, 9, 9, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 9, 9, 9, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 44, 10, 9, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 9, 125, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 58, 32, 37, 119, 34, 44, 10, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 10, 9, 125, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 67, 108, 111, 115, 101, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 116, 120, 110, 46, 67, 111, 109, 109, 105, 116, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10})
-	box.Add("/fieldProvider.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 117, 116, 105, 108, 115, 34, 10, 41, 10, 10, 47, 47, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 77, 97, 112, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 10, 125, 10, 10, 47, 47, 32, 83, 101, 116, 117, 112, 32, 115, 101, 116, 115, 32, 117, 112, 32, 116, 104, 101, 32, 112, 114, 111, 118, 105, 100, 101, 114, 44, 32, 109, 117, 115, 116, 32, 98, 101, 32, 99, 97, 108, 108, 101, 100, 32, 111, 110, 32, 105, 110, 105, 116, 105, 97, 108, 105, 122, 97, 116, 105, 111, 110, 32, 40, 98, 101, 102, 111, 114, 101, 32, 111, 116, 104, 101, 114, 10, 47, 47, 32, 99, 97, 108, 108, 115, 32, 116, 111, 32, 80, 114, 111, 118, 105, 100, 101, 82, 111, 119, 41, 46, 10, 102, 117, 110, 99, 32, 40, 112, 32, 42, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 41, 32, 83, 101, 116, 117, 112, 40, 104, 101, 97, 100, 101, 114, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 110, 97, 109, 101, 77, 97, 112, 32, 58, 61, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 115, 116, 114, 105, 110, 103, 123, 10, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 9, 9, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 58, 32, 34, 123, 123, 46, 67, 111, 108, 117, 109, 110, 125, 125, 34, 44, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 125, 10, 10, 9, 102, 105, 101, 108, 100, 77, 97, 112, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 67, 114, 101, 97, 116, 101, 72, 101, 97, 100, 101, 114, 40, 104, 101, 97, 100, 101, 114, 44, 32, 114, 101, 110, 97, 109, 101, 77, 97, 112, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 112, 46, 102, 105, 101, 108, 100, 77, 97, 112, 32, 61, 32, 102, 105, 101, 108, 100, 77, 97, 112, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 32, 112, 114, 111, 118, 105, 100, 101, 32, 97, 32, 114, 111, 119, 32, 116, 111, 32, 98, 101, 32, 97, 99, 99, 101, 115, 115, 101, 100, 32, 117, 115, 105, 110, 103, 32, 109, 97, 112, 32, 111, 102, 32, 102, 105, 101, 108, 100, 32, 110, 97, 109, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 112, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 41, 32, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 40, 114, 111, 119, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 117, 116, 105, 108, 115, 46, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 40, 112, 46, 102, 105, 101, 108, 100, 77, 97, 112, 44, 32, 114, 111, 119, 41, 10, 125, 10})
-	box.Add("/main.go.tmpl", []byte{112, 97, 99, 107, 97, 103, 101, 32, 109, 97, 105, 110, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 34, 111, 115, 34, 10, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 117, 114, 102, 97, 118, 101, 47, 99, 108, 105, 47, 118, 50, 34, 10, 41, 10, 10, 102, 117, 110, 99, 32, 109, 97, 105, 110, 40, 41, 32, 123, 10, 9, 118, 97, 114, 32, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 10, 9, 100, 114, 121, 82, 117, 110, 32, 61, 32, 116, 114, 117, 101, 10, 10, 9, 97, 112, 112, 32, 58, 61, 32, 38, 99, 108, 105, 46, 65, 112, 112, 123, 10, 9, 9, 70, 108, 97, 103, 115, 58, 32, 91, 93, 99, 108, 105, 46, 70, 108, 97, 103, 123, 10, 9, 9, 9, 38, 99, 108, 105, 46, 66, 111, 111, 108, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 34, 100, 114, 121, 45, 114, 117, 110, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 114, 117, 110, 32, 116, 97, 114, 103, 101, 116, 32, 119, 105, 116, 104, 111, 117, 116, 32, 97, 99, 116, 117, 97, 108, 108, 121, 32, 101, 120, 101, 99, 117, 116, 101, 32, 105, 116, 34, 44, 10, 9, 9, 9, 9, 86, 97, 108, 117, 101, 58, 32, 102, 97, 108, 115, 101, 44, 10, 9, 9, 9, 9, 68, 101, 115, 116, 105, 110, 97, 116, 105, 111, 110, 58, 32, 38, 100, 114, 121, 82, 117, 110, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 125, 44, 10, 9, 9, 67, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 97, 108, 108, 85, 112, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 99, 114, 101, 97, 116, 101, 32, 97, 108, 108, 32, 116, 97, 114, 103, 101, 116, 34, 44, 10, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 65, 108, 108, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 97, 108, 108, 68, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 100, 114, 111, 112, 32, 97, 108, 108, 32, 116, 97, 114, 103, 101, 116, 34, 44, 10, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 65, 108, 108, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 109, 97, 110, 97, 103, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 83, 117, 98, 99, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 117, 112, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 99, 114, 101, 97, 116, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 100, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 100, 114, 111, 112, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 9, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 109, 97, 110, 97, 103, 101, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 83, 117, 98, 99, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 117, 112, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 99, 114, 101, 97, 116, 101, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 108, 115, 111, 32, 101, 120, 112, 111, 114, 116, 32, 100, 97, 116, 97, 32, 105, 102, 32, 115, 112, 101, 99, 105, 102, 105, 101, 100, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 100, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 100, 114, 111, 112, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 9, 125, 44, 10, 9, 125, 10, 10, 9, 101, 114, 114, 32, 58, 61, 32, 97, 112, 112, 46, 82, 117, 110, 40, 111, 115, 46, 65, 114, 103, 115, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 101, 120, 105, 116, 87, 105, 116, 104, 69, 114, 114, 111, 114, 40, 101, 114, 114, 41, 10, 9, 125, 10, 125, 10})
+	box.Add("/computer.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 117, 116, 101, 114, 73, 109, 112, 111, 114, 116, 115, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 114, 73, 109, 112, 111, 114, 116, 115, 125, 125, 10, 9, 34, 123, 123, 46, 125, 125, 34, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 67, 111, 109, 112, 117, 116, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 111, 109, 112, 117, 116, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 67, 111, 109, 112, 117, 116, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 32, 102, 117, 110, 99, 32, 40, 123, 123, 46, 65, 114, 103, 117, 109, 101, 110, 116, 84, 121, 112, 101, 125, 125, 41, 32, 40, 123, 123, 46, 82, 101, 116, 117, 114, 110, 84, 121, 112, 101, 125, 125, 44, 32, 101, 114, 114, 111, 114, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 10, 47, 47, 32, 67, 111, 109, 112, 117, 116, 101, 32, 99, 111, 109, 112, 117, 116, 101, 32, 102, 105, 101, 108, 100, 39, 115, 32, 118, 97, 108, 117, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 99, 32, 67, 111, 109, 112, 117, 116, 101, 114, 41, 32, 67, 111, 109, 112, 117, 116, 101, 40, 102, 105, 101, 108, 100, 115, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 10, 32, 32, 32, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 99, 46, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 123, 123, 46, 71, 111, 84, 121, 112, 101, 125, 125, 41, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 99, 46, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 125, 125, 40, 102, 105, 101, 108, 100, 115, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 105, 101, 108, 100, 115, 44, 32, 110, 105, 108, 10, 125, 10})
+	box.Add("/converter.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 83, 116, 114, 99, 111, 110, 118, 80, 107, 103, 125, 125, 10, 9, 34, 115, 116, 114, 99, 111, 110, 118, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 84, 105, 109, 101, 80, 107, 103, 125, 125, 10, 9, 34, 116, 105, 109, 101, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 117, 116, 105, 108, 115, 34, 10, 41, 10, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 101, 110, 117, 109, 34, 125, 125, 10, 10, 47, 47, 32, 101, 110, 117, 109, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 116, 104, 101, 32, 118, 97, 108, 117, 101, 115, 32, 97, 108, 108, 111, 119, 101, 100, 32, 105, 110, 32, 102, 105, 101, 108, 100, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 46, 10, 118, 97, 114, 32, 101, 110, 117, 109, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 61, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 35, 118, 34, 32, 46, 69, 110, 117, 109, 86, 97, 108, 117, 101, 115, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 46, 84, 114, 117, 101, 86, 97, 108, 117, 101, 115, 125, 125, 10, 10, 47, 47, 32, 98, 111, 111, 108, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 116, 104, 101, 32, 98, 111, 111, 108, 101, 97, 110, 32, 108, 105, 116, 101, 114, 97, 108, 115, 32, 111, 102, 32, 102, 105, 101, 108, 100, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 46, 10, 118, 97, 114, 32, 98, 111, 111, 108, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 61, 32, 117, 116, 105, 108, 115, 46, 66, 111, 111, 108, 76, 105, 116, 101, 114, 97, 108, 115, 123, 10, 9, 84, 114, 117, 101, 58, 32, 32, 32, 32, 32, 32, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 35, 118, 34, 32, 46, 84, 114, 117, 101, 86, 97, 108, 117, 101, 115, 125, 125, 44, 10, 9, 70, 97, 108, 115, 101, 58, 32, 32, 32, 32, 32, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 35, 118, 34, 32, 46, 70, 97, 108, 115, 101, 86, 97, 108, 117, 101, 115, 125, 125, 44, 10, 9, 73, 103, 110, 111, 114, 101, 67, 97, 115, 101, 58, 32, 123, 123, 46, 73, 103, 110, 111, 114, 101, 67, 97, 115, 101, 125, 125, 44, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 125, 10, 10, 47, 47, 32, 67, 111, 110, 118, 101, 114, 116, 32, 99, 111, 110, 118, 101, 114, 116, 115, 32, 102, 105, 101, 108, 100, 39, 115, 32, 118, 97, 108, 117, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 99, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 41, 32, 67, 111, 110, 118, 101, 114, 116, 40, 102, 105, 101, 108, 100, 115, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 97, 114, 114, 97, 121, 34, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 110, 117, 109, 101, 114, 105, 99, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 109, 101, 114, 105, 99, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 44, 32, 123, 123, 46, 80, 114, 101, 99, 105, 115, 105, 111, 110, 125, 125, 44, 32, 123, 123, 46, 83, 99, 97, 108, 101, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 109, 111, 110, 101, 121, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 77, 111, 110, 101, 121, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 98, 111, 111, 108, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 66, 111, 111, 108, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 102, 108, 111, 97, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 70, 108, 111, 97, 116, 54, 52, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 105, 110, 116, 51, 50, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 73, 110, 116, 51, 50, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 105, 110, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 73, 110, 116, 54, 52, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 69, 108, 101, 109, 84, 121, 112, 101, 32, 34, 116, 105, 109, 101, 46, 84, 105, 109, 101, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 84, 105, 109, 101, 65, 114, 114, 97, 121, 40, 34, 123, 123, 46, 84, 105, 109, 101, 70, 111, 114, 109, 97, 116, 125, 125, 34, 44, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 83, 116, 114, 105, 110, 103, 65, 114, 114, 97, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 65, 114, 114, 97, 121, 68, 101, 108, 105, 109, 105, 116, 101, 114, 125, 125, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 98, 121, 116, 101, 115, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 66, 121, 116, 101, 115, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 34, 123, 123, 46, 66, 105, 110, 97, 114, 121, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 34, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 98, 121, 116, 101, 115, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 66, 121, 116, 101, 115, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 34, 123, 123, 46, 66, 105, 110, 97, 114, 121, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 34, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 105, 110, 116, 101, 114, 118, 97, 108, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 73, 110, 116, 101, 114, 118, 97, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 34, 123, 123, 46, 68, 117, 114, 97, 116, 105, 111, 110, 70, 111, 114, 109, 97, 116, 125, 125, 34, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 105, 110, 116, 101, 114, 118, 97, 108, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 73, 110, 116, 101, 114, 118, 97, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 34, 123, 123, 46, 68, 117, 114, 97, 116, 105, 111, 110, 70, 111, 114, 109, 97, 116, 125, 125, 34, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 101, 110, 117, 109, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 69, 110, 117, 109, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 101, 110, 117, 109, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 101, 110, 117, 109, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 69, 110, 117, 109, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 101, 110, 117, 109, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 110, 117, 109, 101, 114, 105, 99, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 109, 101, 114, 105, 99, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 46, 80, 114, 101, 99, 105, 115, 105, 111, 110, 125, 125, 44, 32, 123, 123, 46, 83, 99, 97, 108, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 110, 117, 109, 101, 114, 105, 99, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 78, 117, 109, 101, 114, 105, 99, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 123, 123, 46, 80, 114, 101, 99, 105, 115, 105, 111, 110, 125, 125, 44, 32, 123, 123, 46, 83, 99, 97, 108, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 109, 111, 110, 101, 121, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 77, 111, 110, 101, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 109, 111, 110, 101, 121, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 77, 111, 110, 101, 121, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 117, 116, 105, 108, 115, 46, 74, 83, 79, 78, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 68, 101, 99, 111, 100, 101, 100, 74, 83, 79, 78, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 117, 116, 105, 108, 115, 46, 74, 83, 79, 78, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 68, 101, 99, 111, 100, 101, 100, 74, 83, 79, 78, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 106, 115, 111, 110, 34, 41, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 74, 83, 79, 78, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 66, 97, 115, 101, 84, 121, 112, 101, 32, 34, 106, 115, 111, 110, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 74, 83, 79, 78, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 98, 111, 111, 108, 34, 41, 32, 46, 84, 114, 117, 101, 86, 97, 108, 117, 101, 115, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 67, 117, 115, 116, 111, 109, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 98, 111, 111, 108, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 97, 110, 100, 32, 40, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 66, 111, 111, 108, 34, 41, 32, 46, 84, 114, 117, 101, 86, 97, 108, 117, 101, 115, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 67, 117, 115, 116, 111, 109, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 98, 111, 111, 108, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 98, 111, 111, 108, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 66, 111, 111, 108, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 66, 111, 111, 108, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 102, 108, 111, 97, 116, 54, 52, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 70, 108, 111, 97, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 54, 52, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 70, 108, 111, 97, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 70, 108, 111, 97, 116, 54, 52, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 105, 110, 116, 51, 50, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 73, 110, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 49, 48, 44, 32, 51, 50, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 73, 110, 116, 51, 50, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 73, 110, 116, 51, 50, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 105, 110, 116, 54, 52, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 116, 114, 99, 111, 110, 118, 46, 80, 97, 114, 115, 101, 73, 110, 116, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 44, 32, 49, 48, 44, 32, 54, 52, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 73, 110, 116, 54, 52, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 73, 110, 116, 54, 52, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 116, 114, 105, 110, 103, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 32, 58, 61, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 83, 116, 114, 105, 110, 103, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 83, 116, 114, 105, 110, 103, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 116, 105, 109, 101, 46, 84, 105, 109, 101, 34, 125, 125, 10, 9, 105, 102, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 69, 114, 114, 69, 109, 112, 116, 121, 86, 97, 108, 117, 101, 40, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 41, 10, 9, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 116, 105, 109, 101, 46, 80, 97, 114, 115, 101, 40, 34, 123, 123, 46, 84, 105, 109, 101, 70, 111, 114, 109, 97, 116, 125, 125, 34, 44, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 71, 111, 84, 121, 112, 101, 32, 34, 115, 113, 108, 46, 78, 117, 108, 108, 84, 105, 109, 101, 34, 125, 125, 10, 9, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 83, 116, 114, 105, 110, 103, 84, 111, 78, 117, 108, 108, 84, 105, 109, 101, 40, 34, 123, 123, 46, 84, 105, 109, 101, 70, 111, 114, 109, 97, 116, 125, 125, 34, 44, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 46, 40, 115, 116, 114, 105, 110, 103, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 117, 116, 105, 108, 115, 46, 70, 105, 101, 108, 100, 69, 114, 114, 111, 114, 123, 70, 105, 101, 108, 100, 58, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 32, 77, 101, 115, 115, 97, 103, 101, 58, 32, 101, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 125, 10, 9, 125, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 32, 61, 32, 118, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 78, 97, 109, 101, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 105, 101, 108, 100, 115, 44, 32, 110, 105, 108, 10, 125, 10})
+	box.Add("/dbSync.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 100, 97, 116, 97, 98, 97, 115, 101, 47, 115, 113, 108, 34, 10, 32, 32, 32, 32, 34, 102, 109, 116, 34, 10, 32, 32, 32, 32, 34, 105, 111, 34, 10, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 108, 105, 98, 47, 112, 113, 34, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 67, 111, 109, 112, 117, 116, 101, 100, 125, 125, 10, 9, 34, 123, 123, 46, 67, 111, 109, 112, 117, 116, 101, 80, 97, 99, 107, 97, 103, 101, 125, 125, 34, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 47, 47, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 46, 10, 116, 121, 112, 101, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 32, 32, 32, 32, 110, 97, 109, 101, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 67, 114, 101, 97, 116, 101, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 68, 101, 108, 101, 116, 101, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 9, 115, 113, 108, 68, 114, 111, 112, 32, 32, 32, 32, 32, 32, 115, 116, 114, 105, 110, 103, 10, 32, 32, 32, 32, 100, 101, 112, 101, 110, 100, 115, 79, 110, 32, 32, 32, 32, 91, 93, 115, 116, 114, 105, 110, 103, 10, 32, 32, 32, 32, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 32, 42, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 10, 125, 10, 10, 47, 47, 32, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 99, 114, 101, 97, 116, 101, 115, 32, 97, 32, 110, 101, 119, 32, 105, 110, 115, 116, 97, 110, 99, 101, 46, 10, 102, 117, 110, 99, 32, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 40, 41, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 123, 10, 32, 32, 32, 32, 114, 32, 58, 61, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 78, 97, 109, 101, 58, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 82, 111, 119, 82, 101, 97, 100, 101, 114, 58, 32, 32, 32, 32, 32, 78, 101, 119, 82, 111, 119, 82, 101, 97, 100, 101, 114, 40, 34, 34, 44, 32, 100, 97, 116, 97, 83, 111, 117, 114, 99, 101, 115, 41, 44, 10, 9, 9, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 58, 32, 38, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 123, 125, 44, 10, 9, 9, 67, 111, 110, 118, 101, 114, 116, 101, 114, 58, 32, 32, 32, 32, 32, 67, 111, 110, 118, 101, 114, 116, 101, 114, 123, 125, 44, 10, 9, 9, 67, 111, 109, 112, 117, 116, 101, 114, 58, 32, 32, 32, 32, 32, 32, 67, 111, 109, 112, 117, 116, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 70, 110, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 58, 32, 123, 123, 36, 46, 67, 111, 109, 112, 117, 116, 101, 80, 107, 103, 86, 97, 114, 125, 125, 46, 123, 123, 46, 78, 97, 109, 101, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 9, 9, 86, 97, 108, 105, 100, 97, 116, 111, 114, 58, 32, 32, 32, 32, 32, 86, 97, 108, 105, 100, 97, 116, 111, 114, 123, 125, 44, 10, 9, 125, 10, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 110, 97, 109, 101, 58, 32, 34, 123, 123, 46, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 100, 101, 112, 101, 110, 100, 115, 79, 110, 58, 32, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 101, 112, 101, 110, 100, 115, 79, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 96, 123, 123, 46, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 58, 32, 114, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 68, 101, 108, 101, 116, 101, 58, 32, 96, 68, 69, 76, 69, 84, 69, 32, 70, 82, 79, 77, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 68, 114, 111, 112, 58, 32, 96, 68, 82, 79, 80, 32, 84, 65, 66, 76, 69, 32, 73, 70, 32, 69, 88, 73, 83, 84, 83, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 113, 108, 67, 114, 101, 97, 116, 101, 58, 32, 96, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 67, 82, 69, 65, 84, 69, 32, 84, 65, 66, 76, 69, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 32, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 58, 61, 32, 116, 114, 117, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 36, 102, 105, 114, 115, 116, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 61, 32, 102, 97, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 44, 123, 123, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 32, 123, 123, 46, 83, 81, 76, 84, 121, 112, 101, 125, 125, 32, 123, 123, 45, 32, 105, 102, 32, 46, 82, 101, 113, 117, 105, 114, 101, 100, 125, 125, 32, 78, 79, 84, 32, 78, 85, 76, 76, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 32, 123, 123, 46, 83, 81, 76, 84, 121, 112, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 110, 115, 116, 114, 97, 105, 110, 116, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 46, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 36, 102, 105, 114, 115, 116, 32, 58, 61, 32, 102, 97, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 96, 44, 10, 32, 32, 32, 32, 125, 10, 125, 10, 10, 47, 47, 32, 78, 97, 109, 101, 32, 114, 101, 116, 117, 114, 110, 115, 32, 116, 104, 101, 32, 116, 97, 98, 108, 101, 39, 115, 32, 110, 97, 109, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 78, 97, 109, 101, 40, 41, 32, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 110, 97, 109, 101, 10, 125, 10, 10, 47, 47, 32, 82, 111, 119, 67, 111, 117, 110, 116, 32, 114, 101, 116, 117, 114, 110, 115, 32, 110, 117, 109, 98, 101, 114, 32, 111, 102, 32, 114, 111, 119, 115, 32, 116, 104, 97, 116, 32, 105, 115, 32, 102, 105, 108, 108, 101, 100, 46, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 32, 105, 110, 116, 54, 52, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 10, 125, 10, 10, 47, 47, 32, 87, 97, 114, 110, 105, 110, 103, 115, 32, 114, 101, 116, 117, 114, 110, 115, 32, 116, 104, 101, 32, 119, 97, 114, 110, 105, 110, 103, 115, 32, 102, 111, 117, 110, 100, 32, 111, 110, 32, 116, 104, 101, 32, 108, 97, 115, 116, 32, 70, 105, 108, 108, 46, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 87, 97, 114, 110, 105, 110, 103, 115, 40, 41, 32, 91, 93, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 87, 97, 114, 110, 105, 110, 103, 115, 40, 41, 10, 125, 10, 10, 47, 47, 32, 83, 101, 116, 83, 111, 117, 114, 99, 101, 32, 115, 101, 116, 115, 32, 116, 104, 101, 32, 100, 97, 116, 97, 32, 115, 111, 117, 114, 99, 101, 32, 112, 97, 116, 104, 115, 32, 116, 111, 32, 102, 105, 108, 108, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 46, 32, 82, 101, 108, 97, 116, 105, 118, 101, 32, 112, 97, 116, 104, 115, 32, 97, 114, 101, 10, 47, 47, 32, 114, 101, 115, 111, 108, 118, 101, 100, 32, 97, 103, 97, 105, 110, 115, 116, 32, 100, 97, 116, 97, 68, 105, 114, 46, 32, 73, 102, 32, 110, 111, 32, 115, 111, 117, 114, 99, 101, 115, 32, 103, 105, 118, 101, 110, 44, 32, 116, 104, 101, 32, 115, 111, 117, 114, 99, 101, 115, 32, 105, 110, 32, 115, 112, 101, 99, 32, 97, 114, 101, 32, 117, 115, 101, 100, 46, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 83, 101, 116, 83, 111, 117, 114, 99, 101, 40, 100, 97, 116, 97, 68, 105, 114, 32, 115, 116, 114, 105, 110, 103, 44, 32, 115, 111, 117, 114, 99, 101, 115, 32, 46, 46, 46, 115, 116, 114, 105, 110, 103, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 108, 101, 110, 40, 115, 111, 117, 114, 99, 101, 115, 41, 32, 61, 61, 32, 48, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 111, 117, 114, 99, 101, 115, 32, 61, 32, 100, 97, 116, 97, 83, 111, 117, 114, 99, 101, 115, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 101, 114, 114, 32, 58, 61, 32, 99, 104, 101, 99, 107, 83, 111, 117, 114, 99, 101, 115, 40, 115, 111, 117, 114, 99, 101, 115, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 32, 61, 32, 78, 101, 119, 82, 111, 119, 82, 101, 97, 100, 101, 114, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 68, 101, 112, 101, 110, 100, 115, 79, 110, 32, 114, 101, 116, 117, 114, 110, 115, 32, 111, 116, 104, 101, 114, 32, 116, 97, 98, 108, 101, 115, 32, 116, 104, 97, 116, 32, 116, 104, 105, 115, 32, 116, 97, 98, 108, 101, 32, 100, 101, 112, 101, 110, 100, 115, 32, 111, 110, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 101, 112, 101, 110, 100, 115, 79, 110, 40, 41, 32, 91, 93, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 100, 46, 100, 101, 112, 101, 110, 100, 115, 79, 110, 10, 125, 10, 10, 47, 47, 32, 67, 114, 101, 97, 116, 101, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 67, 114, 101, 97, 116, 101, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 67, 114, 101, 97, 116, 101, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 68, 101, 108, 101, 116, 101, 32, 97, 108, 108, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 101, 108, 101, 116, 101, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 68, 101, 108, 101, 116, 101, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 68, 114, 111, 112, 32, 116, 97, 98, 108, 101, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 68, 114, 111, 112, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 100, 46, 115, 113, 108, 68, 114, 111, 112, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 125, 10, 10, 47, 47, 32, 70, 105, 108, 108, 32, 114, 111, 119, 115, 10, 102, 117, 110, 99, 32, 40, 100, 32, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 41, 32, 70, 105, 108, 108, 40, 100, 98, 32, 42, 115, 113, 108, 46, 68, 66, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 101, 114, 114, 32, 58, 61, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 79, 112, 101, 110, 40, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 9, 100, 101, 102, 101, 114, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 9, 116, 120, 110, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 66, 101, 103, 105, 110, 40, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 32, 32, 32, 32, 115, 116, 109, 116, 44, 32, 101, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 80, 114, 101, 112, 97, 114, 101, 40, 112, 113, 46, 123, 123, 105, 102, 32, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 67, 111, 112, 121, 73, 110, 83, 99, 104, 101, 109, 97, 123, 123, 101, 108, 115, 101, 125, 125, 67, 111, 112, 121, 73, 110, 123, 123, 101, 110, 100, 125, 125, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 83, 99, 104, 101, 109, 97, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 41, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 102, 111, 114, 32, 123, 10, 9, 9, 114, 101, 99, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 101, 97, 100, 82, 101, 99, 111, 114, 100, 40, 41, 10, 9, 9, 105, 102, 32, 101, 114, 114, 32, 61, 61, 32, 105, 111, 46, 69, 79, 70, 32, 123, 10, 9, 9, 9, 98, 114, 101, 97, 107, 10, 9, 9, 125, 10, 9, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 9, 9, 9, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 10, 9, 9, 9, 125, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 9, 9, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 73, 115, 65, 114, 114, 97, 121, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 112, 113, 46, 65, 114, 114, 97, 121, 40, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 109, 112, 117, 116, 101, 100, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 69, 120, 99, 108, 117, 100, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 73, 115, 65, 114, 114, 97, 121, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 112, 113, 46, 65, 114, 114, 97, 121, 40, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 99, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 9, 9, 9, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 32, 105, 110, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 101, 99, 111, 114, 100, 78, 117, 109, 40, 41, 44, 10, 9, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 83, 111, 117, 114, 99, 101, 40, 41, 44, 10, 9, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 9, 125, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 32, 114, 101, 99, 111, 114, 100, 32, 35, 37, 100, 32, 105, 110, 32, 37, 115, 58, 32, 37, 119, 34, 44, 10, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 82, 101, 99, 111, 114, 100, 78, 117, 109, 40, 41, 44, 10, 9, 9, 9, 9, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 83, 111, 117, 114, 99, 101, 40, 41, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 10, 9, 125, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 69, 120, 101, 99, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 115, 116, 109, 116, 46, 67, 108, 111, 115, 101, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 116, 120, 110, 46, 67, 111, 109, 109, 105, 116, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 58, 61, 32, 116, 120, 110, 46, 82, 111, 108, 108, 98, 97, 99, 107, 40, 41, 59, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 10, 9, 9, 9, 9, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 102, 97, 105, 108, 101, 100, 32, 116, 111, 32, 114, 111, 108, 108, 98, 97, 99, 107, 58, 32, 37, 115, 58, 32, 37, 119, 34, 44, 32, 114, 111, 108, 108, 98, 97, 99, 107, 69, 114, 114, 46, 69, 114, 114, 111, 114, 40, 41, 44, 32, 101, 114, 114, 41, 41, 10, 9, 9, 125, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 37, 115, 58, 32, 37, 119, 34, 44, 32, 100, 46, 114, 101, 99, 111, 114, 100, 82, 101, 97, 100, 101, 114, 46, 78, 97, 109, 101, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10})
+	box.Add("/fieldProvider.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 117, 116, 105, 108, 115, 34, 10, 41, 10, 10, 47, 47, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 10, 32, 32, 32, 32, 102, 105, 101, 108, 100, 77, 97, 112, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 10, 32, 32, 32, 32, 119, 97, 114, 110, 105, 110, 103, 115, 32, 91, 93, 115, 116, 114, 105, 110, 103, 10, 125, 10, 10, 47, 47, 32, 83, 101, 116, 117, 112, 32, 115, 101, 116, 115, 32, 117, 112, 32, 116, 104, 101, 32, 112, 114, 111, 118, 105, 100, 101, 114, 44, 32, 109, 117, 115, 116, 32, 98, 101, 32, 99, 97, 108, 108, 101, 100, 32, 111, 110, 32, 105, 110, 105, 116, 105, 97, 108, 105, 122, 97, 116, 105, 111, 110, 32, 40, 98, 101, 102, 111, 114, 101, 32, 111, 116, 104, 101, 114, 10, 47, 47, 32, 99, 97, 108, 108, 115, 32, 116, 111, 32, 80, 114, 111, 118, 105, 100, 101, 82, 111, 119, 41, 46, 10, 102, 117, 110, 99, 32, 40, 112, 32, 42, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 41, 32, 83, 101, 116, 117, 112, 40, 104, 101, 97, 100, 101, 114, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 105, 102, 32, 46, 80, 111, 115, 105, 116, 105, 111, 110, 97, 108, 125, 125, 10, 32, 32, 32, 32, 112, 46, 102, 105, 101, 108, 100, 77, 97, 112, 32, 61, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 123, 10, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 9, 9, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 58, 32, 123, 123, 46, 67, 111, 108, 117, 109, 110, 73, 110, 100, 101, 120, 125, 125, 44, 10, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 115, 112, 101, 99, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 72, 101, 97, 100, 101, 114, 83, 112, 101, 99, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 108, 117, 109, 110, 115, 58, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 58, 32, 123, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 36, 105, 44, 32, 36, 99, 32, 58, 61, 32, 46, 67, 111, 108, 117, 109, 110, 115, 125, 125, 123, 123, 105, 102, 32, 36, 105, 125, 125, 44, 32, 123, 123, 101, 110, 100, 125, 125, 34, 123, 123, 36, 99, 125, 125, 34, 123, 123, 101, 110, 100, 32, 45, 125, 125, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 101, 97, 100, 101, 114, 77, 97, 116, 99, 104, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 77, 97, 116, 99, 104, 58, 32, 34, 123, 123, 46, 72, 101, 97, 100, 101, 114, 77, 97, 116, 99, 104, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 116, 114, 97, 67, 111, 108, 117, 109, 110, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 69, 120, 116, 114, 97, 67, 111, 108, 117, 109, 110, 115, 58, 32, 34, 123, 123, 46, 69, 120, 116, 114, 97, 67, 111, 108, 117, 109, 110, 115, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 70, 105, 108, 101, 78, 97, 109, 101, 67, 111, 108, 117, 109, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 67, 111, 108, 117, 109, 110, 58, 32, 34, 123, 123, 46, 70, 105, 108, 101, 78, 97, 109, 101, 67, 111, 108, 117, 109, 110, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 79, 112, 116, 105, 111, 110, 97, 108, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 79, 112, 116, 105, 111, 110, 97, 108, 58, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 98, 111, 111, 108, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 79, 112, 116, 105, 111, 110, 97, 108, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 58, 32, 116, 114, 117, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 125, 10, 10, 9, 102, 105, 101, 108, 100, 77, 97, 112, 44, 32, 119, 97, 114, 110, 105, 110, 103, 115, 44, 32, 101, 114, 114, 32, 58, 61, 32, 117, 116, 105, 108, 115, 46, 82, 101, 115, 111, 108, 118, 101, 72, 101, 97, 100, 101, 114, 40, 104, 101, 97, 100, 101, 114, 44, 32, 115, 112, 101, 99, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 112, 46, 102, 105, 101, 108, 100, 77, 97, 112, 32, 61, 32, 102, 105, 101, 108, 100, 77, 97, 112, 10, 32, 32, 32, 32, 112, 46, 119, 97, 114, 110, 105, 110, 103, 115, 32, 61, 32, 119, 97, 114, 110, 105, 110, 103, 115, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 10, 47, 47, 32, 87, 97, 114, 110, 105, 110, 103, 115, 32, 114, 101, 116, 117, 114, 110, 115, 32, 116, 104, 101, 32, 119, 97, 114, 110, 105, 110, 103, 115, 32, 102, 111, 117, 110, 100, 32, 111, 110, 32, 83, 101, 116, 117, 112, 46, 10, 102, 117, 110, 99, 32, 40, 112, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 41, 32, 87, 97, 114, 110, 105, 110, 103, 115, 40, 41, 32, 91, 93, 115, 116, 114, 105, 110, 103, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 112, 46, 119, 97, 114, 110, 105, 110, 103, 115, 10, 125, 10, 10, 47, 47, 32, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 32, 112, 114, 111, 118, 105, 100, 101, 32, 97, 32, 114, 111, 119, 32, 116, 111, 32, 98, 101, 32, 97, 99, 99, 101, 115, 115, 101, 100, 32, 117, 115, 105, 110, 103, 32, 109, 97, 112, 32, 111, 102, 32, 102, 105, 101, 108, 100, 32, 110, 97, 109, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 112, 32, 70, 105, 101, 108, 100, 80, 114, 111, 118, 105, 100, 101, 114, 41, 32, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 40, 114, 111, 119, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 117, 116, 105, 108, 115, 46, 80, 114, 111, 118, 105, 100, 101, 70, 105, 101, 108, 100, 40, 112, 46, 102, 105, 101, 108, 100, 77, 97, 112, 44, 32, 114, 111, 119, 41, 10, 125, 10})
+	box.Add("/main.go.tmpl", []byte{112, 97, 99, 107, 97, 103, 101, 32, 109, 97, 105, 110, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 34, 111, 115, 34, 10, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 117, 114, 102, 97, 118, 101, 47, 99, 108, 105, 47, 118, 50, 34, 10, 41, 10, 10, 102, 117, 110, 99, 32, 109, 97, 105, 110, 40, 41, 32, 123, 10, 9, 118, 97, 114, 32, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 10, 9, 118, 97, 114, 32, 100, 97, 116, 97, 68, 105, 114, 32, 115, 116, 114, 105, 110, 103, 10, 9, 100, 114, 121, 82, 117, 110, 32, 61, 32, 116, 114, 117, 101, 10, 10, 9, 97, 112, 112, 32, 58, 61, 32, 38, 99, 108, 105, 46, 65, 112, 112, 123, 10, 9, 9, 70, 108, 97, 103, 115, 58, 32, 91, 93, 99, 108, 105, 46, 70, 108, 97, 103, 123, 10, 9, 9, 9, 38, 99, 108, 105, 46, 66, 111, 111, 108, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 34, 100, 114, 121, 45, 114, 117, 110, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 114, 117, 110, 32, 116, 97, 114, 103, 101, 116, 32, 119, 105, 116, 104, 111, 117, 116, 32, 97, 99, 116, 117, 97, 108, 108, 121, 32, 101, 120, 101, 99, 117, 116, 101, 32, 105, 116, 34, 44, 10, 9, 9, 9, 9, 86, 97, 108, 117, 101, 58, 32, 102, 97, 108, 115, 101, 44, 10, 9, 9, 9, 9, 68, 101, 115, 116, 105, 110, 97, 116, 105, 111, 110, 58, 32, 38, 100, 114, 121, 82, 117, 110, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 38, 99, 108, 105, 46, 83, 116, 114, 105, 110, 103, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 34, 100, 97, 116, 97, 45, 100, 105, 114, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 114, 101, 115, 111, 108, 118, 101, 32, 114, 101, 108, 97, 116, 105, 118, 101, 32, 100, 97, 116, 97, 32, 115, 111, 117, 114, 99, 101, 32, 112, 97, 116, 104, 115, 32, 97, 103, 97, 105, 110, 115, 116, 32, 96, 68, 73, 82, 96, 34, 44, 10, 9, 9, 9, 9, 68, 101, 115, 116, 105, 110, 97, 116, 105, 111, 110, 58, 32, 38, 100, 97, 116, 97, 68, 105, 114, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 38, 99, 108, 105, 46, 83, 116, 114, 105, 110, 103, 83, 108, 105, 99, 101, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 34, 115, 111, 117, 114, 99, 101, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 111, 118, 101, 114, 114, 105, 100, 101, 32, 100, 97, 116, 97, 32, 115, 111, 117, 114, 99, 101, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 117, 115, 105, 110, 103, 32, 102, 111, 114, 109, 97, 116, 32, 96, 82, 69, 70, 61, 80, 65, 84, 72, 96, 44, 32, 99, 97, 110, 32, 98, 101, 32, 114, 101, 112, 101, 97, 116, 101, 100, 32, 40, 117, 115, 101, 32, 45, 32, 97, 115, 32, 80, 65, 84, 72, 32, 116, 111, 32, 114, 101, 97, 100, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 105, 110, 112, 117, 116, 41, 34, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 125, 44, 10, 9, 9, 66, 101, 102, 111, 114, 101, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 115, 101, 116, 117, 112, 83, 111, 117, 114, 99, 101, 115, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 99, 46, 83, 116, 114, 105, 110, 103, 83, 108, 105, 99, 101, 40, 34, 115, 111, 117, 114, 99, 101, 34, 41, 41, 10, 9, 9, 125, 44, 10, 9, 9, 67, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 97, 108, 108, 85, 112, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 99, 114, 101, 97, 116, 101, 32, 97, 108, 108, 32, 116, 97, 114, 103, 101, 116, 34, 44, 10, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 65, 108, 108, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 97, 108, 108, 68, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 100, 114, 111, 112, 32, 97, 108, 108, 32, 116, 97, 114, 103, 101, 116, 34, 44, 10, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 65, 108, 108, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 109, 97, 110, 97, 103, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 83, 117, 98, 99, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 117, 112, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 99, 114, 101, 97, 116, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 70, 108, 97, 103, 115, 58, 32, 91, 93, 99, 108, 105, 46, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 9, 9, 9, 38, 99, 108, 105, 46, 66, 111, 111, 108, 70, 108, 97, 103, 123, 10, 9, 9, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 34, 102, 114, 111, 109, 45, 115, 116, 100, 105, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 102, 105, 108, 108, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 102, 114, 111, 109, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 105, 110, 112, 117, 116, 34, 44, 10, 9, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 105, 102, 32, 99, 46, 66, 111, 111, 108, 40, 34, 102, 114, 111, 109, 45, 115, 116, 100, 105, 110, 34, 41, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 9, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 83, 101, 116, 83, 111, 117, 114, 99, 101, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 83, 116, 100, 105, 110, 83, 111, 117, 114, 99, 101, 41, 10, 9, 9, 9, 9, 9, 9, 9, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 9, 9, 9, 9, 9, 9, 9, 9, 125, 10, 9, 9, 9, 9, 9, 9, 9, 125, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 100, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 100, 114, 111, 112, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 9, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 109, 97, 110, 97, 103, 101, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 83, 117, 98, 99, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 117, 112, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 99, 114, 101, 97, 116, 101, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 108, 115, 111, 32, 101, 120, 112, 111, 114, 116, 32, 100, 97, 116, 97, 32, 105, 102, 32, 115, 112, 101, 99, 105, 102, 105, 101, 100, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 100, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 100, 114, 111, 112, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 9, 9, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 121, 112, 101, 115, 68, 97, 116, 97, 125, 125, 10, 9, 9, 9, 123, 10, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 32, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 32, 32, 34, 109, 97, 110, 97, 103, 101, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 44, 10, 9, 9, 9, 9, 83, 117, 98, 99, 111, 109, 109, 97, 110, 100, 115, 58, 32, 91, 93, 42, 99, 108, 105, 46, 67, 111, 109, 109, 97, 110, 100, 123, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 117, 112, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 114, 101, 99, 114, 101, 97, 116, 101, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 44, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 32, 97, 114, 101, 32, 100, 114, 111, 112, 112, 101, 100, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 123, 10, 9, 9, 9, 9, 9, 9, 78, 97, 109, 101, 58, 32, 32, 34, 100, 111, 119, 110, 34, 44, 10, 9, 9, 9, 9, 9, 9, 85, 115, 97, 103, 101, 58, 32, 34, 100, 114, 111, 112, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 34, 44, 10, 9, 9, 9, 9, 9, 9, 65, 99, 116, 105, 111, 110, 58, 32, 102, 117, 110, 99, 40, 99, 32, 42, 99, 108, 105, 46, 67, 111, 110, 116, 101, 120, 116, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 9, 9, 9, 9, 9, 9, 9, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 41, 10, 9, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 9, 125, 44, 10, 9, 9, 9, 125, 44, 10, 9, 9, 9, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 9, 9, 125, 44, 10, 9, 125, 10, 10, 9, 101, 114, 114, 32, 58, 61, 32, 97, 112, 112, 46, 82, 117, 110, 40, 111, 115, 46, 65, 114, 103, 115, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 101, 120, 105, 116, 87, 105, 116, 104, 69, 114, 114, 111, 114, 40, 101, 114, 114, 41, 10, 9, 125, 10, 125, 10})
+	box.Add("/rowReader.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 101, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 99, 115, 118, 34, 125, 125, 10, 32, 32, 32, 32, 34, 101, 114, 114, 111, 114, 115, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 115, 113, 108, 105, 116, 101, 34, 125, 125, 10, 32, 32, 32, 32, 95, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 109, 97, 116, 116, 110, 47, 103, 111, 45, 115, 113, 108, 105, 116, 101, 51, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 47, 47, 32, 100, 97, 116, 97, 83, 111, 117, 114, 99, 101, 115, 32, 97, 114, 101, 32, 116, 104, 101, 32, 100, 97, 116, 97, 32, 115, 111, 117, 114, 99, 101, 115, 32, 115, 112, 101, 99, 105, 102, 105, 101, 100, 32, 105, 110, 32, 115, 112, 101, 99, 46, 10, 118, 97, 114, 32, 100, 97, 116, 97, 83, 111, 117, 114, 99, 101, 115, 32, 61, 32, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 97, 116, 97, 83, 111, 117, 114, 99, 101, 115, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 46, 125, 125, 34, 44, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 10, 47, 47, 32, 99, 104, 101, 99, 107, 83, 111, 117, 114, 99, 101, 115, 32, 114, 101, 116, 117, 114, 110, 115, 32, 101, 114, 114, 111, 114, 32, 105, 102, 32, 115, 111, 117, 114, 99, 101, 115, 32, 99, 97, 110, 110, 111, 116, 32, 98, 101, 32, 114, 101, 97, 100, 32, 98, 121, 32, 116, 104, 101, 32, 82, 111, 119, 82, 101, 97, 100, 101, 114, 10, 47, 47, 32, 99, 114, 101, 97, 116, 101, 100, 32, 98, 121, 32, 78, 101, 119, 82, 111, 119, 82, 101, 97, 100, 101, 114, 46, 10, 102, 117, 110, 99, 32, 99, 104, 101, 99, 107, 83, 111, 117, 114, 99, 101, 115, 40, 115, 111, 117, 114, 99, 101, 115, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 105, 102, 32, 110, 101, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 99, 115, 118, 34, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 108, 101, 110, 40, 115, 111, 117, 114, 99, 101, 115, 41, 32, 33, 61, 32, 49, 32, 124, 124, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 72, 97, 115, 71, 108, 111, 98, 77, 101, 116, 97, 40, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 111, 114, 115, 46, 78, 101, 119, 40, 34, 123, 123, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 125, 125, 32, 115, 111, 117, 114, 99, 101, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 109, 117, 115, 116, 32, 98, 101, 32, 97, 32, 115, 105, 110, 103, 108, 101, 32, 102, 105, 108, 101, 34, 41, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 111, 114, 32, 40, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 115, 113, 108, 105, 116, 101, 34, 41, 32, 40, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 112, 97, 114, 113, 117, 101, 116, 34, 41, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 32, 61, 61, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 83, 116, 100, 105, 110, 83, 111, 117, 114, 99, 101, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 111, 114, 115, 46, 78, 101, 119, 40, 34, 123, 123, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 125, 125, 32, 115, 111, 117, 114, 99, 101, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 99, 97, 110, 110, 111, 116, 32, 98, 101, 32, 114, 101, 97, 100, 32, 102, 114, 111, 109, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 105, 110, 112, 117, 116, 34, 41, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 78, 101, 119, 82, 111, 119, 82, 101, 97, 100, 101, 114, 32, 99, 114, 101, 97, 116, 101, 115, 32, 97, 32, 110, 101, 119, 32, 82, 111, 119, 82, 101, 97, 100, 101, 114, 32, 102, 111, 114, 32, 115, 111, 117, 114, 99, 101, 115, 46, 32, 82, 101, 108, 97, 116, 105, 118, 101, 32, 112, 97, 116, 104, 115, 32, 105, 110, 32, 115, 111, 117, 114, 99, 101, 115, 10, 47, 47, 32, 97, 114, 101, 32, 114, 101, 115, 111, 108, 118, 101, 100, 32, 97, 103, 97, 105, 110, 115, 116, 32, 100, 97, 116, 97, 68, 105, 114, 46, 10, 102, 117, 110, 99, 32, 78, 101, 119, 82, 111, 119, 82, 101, 97, 100, 101, 114, 40, 100, 97, 116, 97, 68, 105, 114, 32, 115, 116, 114, 105, 110, 103, 44, 32, 115, 111, 117, 114, 99, 101, 115, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 32, 123, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 120, 108, 115, 120, 34, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 88, 76, 83, 88, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 115, 111, 108, 118, 101, 80, 97, 116, 104, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 83, 104, 101, 101, 116, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 83, 104, 101, 101, 116, 58, 32, 34, 123, 123, 46, 83, 104, 101, 101, 116, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 101, 97, 100, 101, 114, 82, 111, 119, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 72, 101, 97, 100, 101, 114, 82, 111, 119, 78, 117, 109, 58, 32, 123, 123, 46, 72, 101, 97, 100, 101, 114, 82, 111, 119, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 101, 108, 108, 82, 97, 110, 103, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 101, 108, 108, 82, 97, 110, 103, 101, 58, 32, 34, 123, 123, 46, 67, 101, 108, 108, 82, 97, 110, 103, 101, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 106, 115, 111, 110, 34, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 74, 83, 79, 78, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 115, 111, 108, 118, 101, 80, 97, 116, 104, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 58, 32, 34, 123, 123, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 108, 117, 109, 110, 115, 58, 32, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 108, 117, 109, 110, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 74, 83, 79, 78, 78, 101, 115, 116, 101, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 78, 101, 115, 116, 101, 100, 58, 32, 34, 123, 123, 46, 74, 83, 79, 78, 78, 101, 115, 116, 101, 100, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 112, 97, 114, 113, 117, 101, 116, 34, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 80, 97, 114, 113, 117, 101, 116, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 115, 111, 108, 118, 101, 80, 97, 116, 104, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 108, 117, 109, 110, 115, 58, 32, 91, 93, 115, 116, 114, 105, 110, 103, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 111, 108, 117, 109, 110, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 115, 113, 108, 105, 116, 101, 34, 125, 125, 10, 32, 32, 32, 32, 102, 105, 108, 101, 78, 97, 109, 101, 32, 58, 61, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 115, 111, 108, 118, 101, 80, 97, 116, 104, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 83, 81, 76, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 68, 114, 105, 118, 101, 114, 78, 97, 109, 101, 58, 32, 34, 115, 113, 108, 105, 116, 101, 51, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 68, 97, 116, 97, 83, 111, 117, 114, 99, 101, 78, 97, 109, 101, 58, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 83, 81, 76, 105, 116, 101, 68, 97, 116, 97, 83, 111, 117, 114, 99, 101, 78, 97, 109, 101, 40, 102, 105, 108, 101, 78, 97, 109, 101, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 78, 97, 109, 101, 58, 32, 102, 105, 108, 101, 78, 97, 109, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 81, 117, 101, 114, 121, 58, 32, 123, 123, 46, 81, 117, 101, 114, 121, 125, 125, 44, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 102, 105, 120, 101, 100, 34, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 70, 105, 120, 101, 100, 87, 105, 100, 116, 104, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 101, 115, 111, 108, 118, 101, 80, 97, 116, 104, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 115, 111, 117, 114, 99, 101, 115, 91, 48, 93, 41, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 58, 32, 34, 123, 123, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 69, 110, 99, 111, 100, 105, 110, 103, 58, 32, 34, 123, 123, 46, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 108, 117, 109, 110, 115, 58, 32, 91, 93, 112, 105, 112, 101, 108, 105, 110, 101, 46, 70, 105, 120, 101, 100, 87, 105, 100, 116, 104, 67, 111, 108, 117, 109, 110, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 83, 116, 97, 114, 116, 58, 32, 123, 123, 46, 83, 116, 97, 114, 116, 125, 125, 44, 32, 69, 110, 100, 58, 32, 123, 123, 46, 69, 110, 100, 125, 125, 123, 123, 105, 102, 32, 46, 84, 114, 105, 109, 125, 125, 44, 32, 84, 114, 105, 109, 58, 32, 34, 123, 123, 46, 84, 114, 105, 109, 125, 125, 34, 123, 123, 101, 110, 100, 125, 125, 125, 44, 32, 47, 47, 32, 123, 123, 46, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 44, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 108, 115, 101, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 78, 101, 119, 70, 105, 108, 101, 115, 82, 101, 97, 100, 101, 114, 40, 10, 32, 32, 32, 32, 32, 32, 32, 32, 100, 97, 116, 97, 68, 105, 114, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 115, 111, 117, 114, 99, 101, 115, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 34, 123, 123, 46, 70, 105, 108, 101, 78, 97, 109, 101, 67, 111, 108, 117, 109, 110, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 110, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 44, 10, 32, 32, 32, 32, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 123, 123, 45, 32, 105, 102, 32, 101, 113, 32, 46, 83, 111, 117, 114, 99, 101, 70, 111, 114, 109, 97, 116, 32, 34, 99, 115, 118, 34, 125, 125, 10, 10, 47, 47, 32, 110, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 32, 99, 114, 101, 97, 116, 101, 115, 32, 97, 32, 110, 101, 119, 32, 67, 83, 86, 82, 101, 97, 100, 101, 114, 46, 10, 102, 117, 110, 99, 32, 110, 101, 119, 67, 83, 86, 82, 101, 97, 100, 101, 114, 40, 102, 105, 108, 101, 78, 97, 109, 101, 32, 115, 116, 114, 105, 110, 103, 41, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 82, 111, 119, 82, 101, 97, 100, 101, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 38, 112, 105, 112, 101, 108, 105, 110, 101, 46, 67, 83, 86, 82, 101, 97, 100, 101, 114, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 105, 108, 101, 78, 97, 109, 101, 58, 32, 102, 105, 108, 101, 78, 97, 109, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 83, 101, 112, 97, 114, 97, 116, 111, 114, 58, 32, 39, 123, 123, 46, 67, 83, 86, 83, 101, 112, 97, 114, 97, 116, 111, 114, 125, 125, 39, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 110, 111, 116, 32, 46, 72, 97, 115, 72, 101, 97, 100, 101, 114, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 78, 111, 72, 101, 97, 100, 101, 114, 58, 32, 116, 114, 117, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 83, 107, 105, 112, 82, 111, 119, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 83, 107, 105, 112, 82, 111, 119, 115, 58, 32, 123, 123, 46, 83, 107, 105, 112, 82, 111, 119, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 83, 107, 105, 112, 70, 111, 111, 116, 101, 114, 82, 111, 119, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 83, 107, 105, 112, 70, 111, 111, 116, 101, 114, 82, 111, 119, 115, 58, 32, 123, 123, 46, 83, 107, 105, 112, 70, 111, 111, 116, 101, 114, 82, 111, 119, 115, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 109, 101, 110, 116, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 109, 109, 101, 110, 116, 58, 32, 123, 123, 46, 67, 111, 109, 109, 101, 110, 116, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 81, 117, 111, 116, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 81, 117, 111, 116, 101, 58, 32, 123, 123, 46, 81, 117, 111, 116, 101, 125, 125, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 76, 97, 122, 121, 81, 117, 111, 116, 101, 115, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 76, 97, 122, 121, 81, 117, 111, 116, 101, 115, 58, 32, 116, 114, 117, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 75, 101, 101, 112, 83, 112, 97, 99, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 75, 101, 101, 112, 76, 101, 97, 100, 105, 110, 103, 83, 112, 97, 99, 101, 58, 32, 116, 114, 117, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 70, 108, 101, 120, 105, 98, 108, 101, 67, 111, 117, 110, 116, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 70, 108, 101, 120, 105, 98, 108, 101, 70, 105, 101, 108, 100, 67, 111, 117, 110, 116, 58, 32, 116, 114, 117, 101, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 58, 32, 34, 123, 123, 46, 67, 111, 109, 112, 114, 101, 115, 115, 105, 111, 110, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 69, 110, 99, 111, 100, 105, 110, 103, 58, 32, 34, 123, 123, 46, 69, 110, 99, 111, 100, 105, 110, 103, 125, 125, 34, 44, 10, 32, 32, 32, 32, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 125, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10})
 	box.Add("/runner.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 109, 97, 105, 110, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 9, 34, 100, 97, 116, 97, 98, 97, 115, 101, 47, 115, 113, 108, 34, 10, 9, 34, 102, 109, 116, 34, 10, 9, 34, 111, 115, 34, 10, 10, 9, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 9, 95, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 108, 105, 98, 47, 112, 113, 34, 10, 41, 10, 10, 118, 97, 114, 32, 99, 102, 103, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 68, 66, 67, 111, 110, 102, 105, 103, 10, 10, 102, 117, 110, 99, 32, 105, 110, 105, 116, 40, 41, 32, 123, 10, 9, 118, 97, 114, 32, 101, 114, 114, 32, 101, 114, 114, 111, 114, 10, 9, 99, 102, 103, 44, 32, 101, 114, 114, 32, 61, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 78, 101, 119, 68, 66, 67, 111, 110, 102, 105, 103, 40, 34, 46, 47, 100, 98, 46, 121, 97, 109, 108, 34, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 101, 120, 105, 116, 87, 105, 116, 104, 69, 114, 114, 111, 114, 40, 101, 114, 114, 41, 10, 9, 125, 10, 125, 10, 10, 102, 117, 110, 99, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 32, 40, 42, 115, 113, 108, 46, 68, 66, 44, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 9, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 115, 113, 108, 46, 79, 112, 101, 110, 40, 34, 112, 111, 115, 116, 103, 114, 101, 115, 34, 44, 32, 99, 102, 103, 46, 67, 111, 110, 110, 101, 99, 116, 105, 111, 110, 83, 116, 114, 105, 110, 103, 40, 41, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 44, 32, 101, 114, 114, 10, 9, 125, 10, 9, 114, 101, 116, 117, 114, 110, 32, 100, 98, 44, 32, 110, 105, 108, 10, 125, 10, 10, 102, 117, 110, 99, 32, 101, 120, 105, 116, 87, 105, 116, 104, 69, 114, 114, 111, 114, 40, 101, 114, 114, 32, 101, 114, 114, 111, 114, 41, 32, 123, 10, 9, 102, 109, 116, 46, 70, 112, 114, 105, 110, 116, 108, 110, 40, 111, 115, 46, 83, 116, 100, 101, 114, 114, 44, 32, 101, 114, 114, 41, 10, 9, 111, 115, 46, 69, 120, 105, 116, 40, 49, 41, 10, 125, 10})
-	box.Add("/targets.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 109, 97, 105, 110, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 102, 109, 116, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 34, 111, 115, 34, 10, 32, 32, 32, 32, 34, 112, 97, 116, 104, 47, 102, 105, 108, 101, 112, 97, 116, 104, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 106, 111, 104, 111, 47, 115, 113, 108, 116, 111, 99, 115, 118, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 46, 73, 109, 112, 111, 114, 116, 80, 97, 116, 104, 125, 125, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 36, 46, 73, 109, 112, 111, 114, 116, 80, 97, 116, 104, 125, 125, 47, 105, 110, 116, 101, 114, 110, 97, 108, 47, 118, 105, 101, 119, 115, 113, 108, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 118, 97, 114, 32, 40, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 46, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 102, 117, 110, 99, 32, 105, 110, 105, 116, 40, 41, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 46, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 40, 41, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 123, 123, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 114, 111, 112, 112, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 68, 114, 111, 112, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 67, 114, 101, 97, 116, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 67, 114, 101, 97, 116, 101, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 32, 100, 101, 108, 101, 116, 101, 115, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 101, 108, 101, 116, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 68, 101, 108, 101, 116, 101, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 101, 108, 101, 116, 101, 32, 100, 101, 108, 101, 116, 101, 115, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 70, 105, 108, 108, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 70, 105, 108, 108, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 102, 40, 34, 32, 37, 100, 32, 114, 101, 99, 111, 114, 100, 115, 32, 91, 79, 75, 93, 92, 110, 34, 44, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 97, 110, 100, 32, 102, 105, 108, 108, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 116, 104, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 114, 111, 112, 112, 105, 110, 103, 32, 118, 105, 101, 119, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 118, 105, 101, 119, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 67, 114, 101, 97, 116, 105, 110, 103, 32, 118, 105, 101, 119, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 118, 105, 101, 119, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 32, 101, 120, 112, 111, 114, 116, 115, 32, 118, 105, 101, 119, 39, 115, 32, 113, 117, 101, 114, 121, 32, 114, 101, 115, 117, 108, 116, 32, 116, 111, 32, 67, 83, 86, 46, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 69, 120, 112, 111, 114, 116, 105, 110, 103, 32, 116, 111, 32, 67, 83, 86, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 105, 114, 32, 58, 61, 32, 102, 105, 108, 101, 112, 97, 116, 104, 46, 68, 105, 114, 40, 96, 123, 123, 46, 69, 120, 112, 111, 114, 116, 125, 125, 96, 41, 10, 32, 32, 32, 32, 101, 114, 114, 32, 58, 61, 32, 111, 115, 46, 77, 107, 100, 105, 114, 65, 108, 108, 40, 100, 105, 114, 44, 32, 48, 55, 55, 55, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 114, 111, 119, 115, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 81, 117, 101, 114, 121, 40, 96, 83, 69, 76, 69, 67, 84, 32, 42, 32, 70, 82, 79, 77, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 114, 111, 119, 115, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 115, 113, 108, 116, 111, 99, 115, 118, 46, 87, 114, 105, 116, 101, 70, 105, 108, 101, 40, 34, 123, 123, 46, 69, 120, 112, 111, 114, 116, 125, 125, 34, 44, 32, 114, 111, 119, 115, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 32, 114, 117, 110, 115, 32, 101, 120, 112, 111, 114, 116, 32, 111, 110, 32, 116, 104, 105, 115, 32, 118, 105, 101, 119, 32, 40, 105, 102, 32, 105, 116, 32, 104, 97, 115, 41, 10, 47, 47, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 65, 108, 108, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 97, 110, 100, 32, 102, 105, 108, 108, 32, 114, 111, 119, 115, 32, 111, 102, 32, 97, 108, 108, 32, 116, 97, 98, 108, 101, 115, 10, 102, 117, 110, 99, 32, 65, 108, 108, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 65, 108, 108, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 97, 108, 108, 32, 116, 97, 98, 108, 101, 115, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 65, 108, 108, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10})
+	box.Add("/targets.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 109, 97, 105, 110, 10, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 102, 109, 116, 34, 10, 32, 32, 32, 32, 34, 115, 116, 114, 105, 110, 103, 115, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 34, 111, 115, 34, 10, 32, 32, 32, 32, 34, 112, 97, 116, 104, 47, 102, 105, 108, 101, 112, 97, 116, 104, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 102, 114, 109, 45, 97, 100, 105, 112, 117, 116, 114, 97, 47, 99, 115, 118, 50, 112, 111, 115, 116, 103, 114, 101, 115, 47, 112, 105, 112, 101, 108, 105, 110, 101, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 106, 111, 104, 111, 47, 115, 113, 108, 116, 111, 99, 115, 118, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 46, 73, 109, 112, 111, 114, 116, 80, 97, 116, 104, 125, 125, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 36, 46, 73, 109, 112, 111, 114, 116, 80, 97, 116, 104, 125, 125, 47, 105, 110, 116, 101, 114, 110, 97, 108, 47, 118, 105, 101, 119, 115, 113, 108, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 121, 112, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 34, 123, 123, 36, 46, 73, 109, 112, 111, 114, 116, 80, 97, 116, 104, 125, 125, 47, 105, 110, 116, 101, 114, 110, 97, 108, 47, 116, 121, 112, 101, 115, 113, 108, 34, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 118, 97, 114, 32, 40, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 46, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 41, 10, 10, 102, 117, 110, 99, 32, 105, 110, 105, 116, 40, 41, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 46, 78, 101, 119, 68, 66, 83, 121, 110, 99, 104, 114, 111, 110, 105, 122, 101, 114, 40, 41, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 125, 10, 10, 47, 47, 32, 115, 101, 116, 117, 112, 83, 111, 117, 114, 99, 101, 115, 32, 115, 101, 116, 115, 32, 116, 104, 101, 32, 100, 97, 116, 97, 32, 115, 111, 117, 114, 99, 101, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 115, 46, 32, 69, 97, 99, 104, 32, 115, 111, 117, 114, 99, 101, 32, 109, 117, 115, 116, 32, 98, 101, 32, 105, 110, 32, 102, 111, 114, 109, 97, 116, 10, 47, 47, 32, 114, 101, 102, 61, 112, 97, 116, 104, 44, 32, 119, 104, 101, 114, 101, 32, 114, 101, 102, 32, 105, 115, 32, 116, 104, 101, 32, 116, 97, 98, 108, 101, 39, 115, 32, 110, 97, 109, 101, 46, 32, 82, 101, 108, 97, 116, 105, 118, 101, 32, 112, 97, 116, 104, 115, 32, 97, 114, 101, 32, 114, 101, 115, 111, 108, 118, 101, 100, 10, 47, 47, 32, 97, 103, 97, 105, 110, 115, 116, 32, 100, 97, 116, 97, 68, 105, 114, 46, 10, 102, 117, 110, 99, 32, 115, 101, 116, 117, 112, 83, 111, 117, 114, 99, 101, 115, 40, 100, 97, 116, 97, 68, 105, 114, 32, 115, 116, 114, 105, 110, 103, 44, 32, 115, 111, 117, 114, 99, 101, 115, 32, 91, 93, 115, 116, 114, 105, 110, 103, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 109, 32, 58, 61, 32, 109, 97, 107, 101, 40, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 91, 93, 115, 116, 114, 105, 110, 103, 41, 10, 32, 32, 32, 32, 115, 116, 100, 105, 110, 32, 58, 61, 32, 102, 97, 108, 115, 101, 10, 32, 32, 32, 32, 102, 111, 114, 32, 95, 44, 32, 115, 32, 58, 61, 32, 114, 97, 110, 103, 101, 32, 115, 111, 117, 114, 99, 101, 115, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 97, 32, 58, 61, 32, 115, 116, 114, 105, 110, 103, 115, 46, 83, 112, 108, 105, 116, 78, 40, 115, 44, 32, 34, 61, 34, 44, 32, 50, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 108, 101, 110, 40, 97, 41, 32, 33, 61, 32, 50, 32, 124, 124, 32, 97, 91, 48, 93, 32, 61, 61, 32, 34, 34, 32, 124, 124, 32, 97, 91, 49, 93, 32, 61, 61, 32, 34, 34, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 105, 110, 118, 97, 108, 105, 100, 32, 115, 111, 117, 114, 99, 101, 32, 39, 37, 115, 39, 58, 32, 109, 117, 115, 116, 32, 98, 101, 32, 105, 110, 32, 102, 111, 114, 109, 97, 116, 32, 114, 101, 102, 61, 112, 97, 116, 104, 34, 44, 32, 115, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 97, 91, 49, 93, 32, 61, 61, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 83, 116, 100, 105, 110, 83, 111, 117, 114, 99, 101, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 105, 102, 32, 115, 116, 100, 105, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 105, 110, 118, 97, 108, 105, 100, 32, 115, 111, 117, 114, 99, 101, 32, 39, 37, 115, 39, 58, 32, 115, 116, 97, 110, 100, 97, 114, 100, 32, 105, 110, 112, 117, 116, 32, 99, 97, 110, 32, 111, 110, 108, 121, 32, 98, 101, 32, 114, 101, 97, 100, 32, 111, 110, 99, 101, 34, 44, 32, 115, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 115, 116, 100, 105, 110, 32, 61, 32, 116, 114, 117, 101, 10, 32, 32, 32, 32, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 109, 91, 97, 91, 48, 93, 93, 32, 61, 32, 97, 112, 112, 101, 110, 100, 40, 109, 91, 97, 91, 48, 93, 93, 44, 32, 97, 91, 49, 93, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 83, 101, 116, 83, 111, 117, 114, 99, 101, 40, 100, 97, 116, 97, 68, 105, 114, 44, 32, 109, 91, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 93, 46, 46, 46, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 108, 101, 116, 101, 40, 109, 44, 32, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 34, 41, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 32, 32, 32, 32, 102, 111, 114, 32, 114, 101, 102, 32, 58, 61, 32, 114, 97, 110, 103, 101, 32, 109, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 105, 110, 118, 97, 108, 105, 100, 32, 115, 111, 117, 114, 99, 101, 58, 32, 117, 110, 107, 110, 111, 119, 110, 32, 116, 97, 98, 108, 101, 32, 39, 37, 115, 39, 34, 44, 32, 114, 101, 102, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 123, 123, 114, 97, 110, 103, 101, 32, 46, 84, 97, 98, 108, 101, 115, 68, 97, 116, 97, 125, 125, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 114, 111, 112, 112, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 68, 114, 111, 112, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 67, 114, 101, 97, 116, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 67, 114, 101, 97, 116, 101, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 32, 100, 101, 108, 101, 116, 101, 115, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 101, 108, 101, 116, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 68, 101, 108, 101, 116, 101, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 101, 108, 101, 116, 101, 32, 100, 101, 108, 101, 116, 101, 115, 32, 114, 111, 119, 115, 32, 102, 114, 111, 109, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 108, 101, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 70, 105, 108, 108, 105, 110, 103, 32, 116, 97, 98, 108, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 70, 105, 108, 108, 40, 100, 98, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 102, 40, 34, 32, 37, 100, 32, 114, 101, 99, 111, 114, 100, 115, 32, 91, 79, 75, 93, 92, 110, 34, 44, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 82, 111, 119, 67, 111, 117, 110, 116, 40, 41, 41, 10, 32, 32, 32, 32, 102, 111, 114, 32, 95, 44, 32, 119, 32, 58, 61, 32, 114, 97, 110, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 68, 66, 46, 87, 97, 114, 110, 105, 110, 103, 115, 40, 41, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 119, 97, 114, 110, 105, 110, 103, 58, 32, 37, 115, 92, 110, 34, 44, 32, 119, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 97, 110, 100, 32, 102, 105, 108, 108, 32, 114, 111, 119, 115, 32, 111, 102, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 116, 104, 101, 32, 116, 97, 98, 108, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 86, 105, 101, 119, 115, 68, 97, 116, 97, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 114, 111, 112, 112, 105, 110, 103, 32, 118, 105, 101, 119, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 118, 105, 101, 119, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 67, 114, 101, 97, 116, 105, 110, 103, 32, 118, 105, 101, 119, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 118, 105, 101, 119, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 32, 102, 105, 108, 108, 115, 32, 114, 111, 119, 115, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 73, 110, 99, 108, 117, 100, 101, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 32, 101, 120, 112, 111, 114, 116, 115, 32, 118, 105, 101, 119, 39, 115, 32, 113, 117, 101, 114, 121, 32, 114, 101, 115, 117, 108, 116, 32, 116, 111, 32, 67, 83, 86, 46, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 69, 120, 112, 111, 114, 116, 105, 110, 103, 32, 116, 111, 32, 67, 83, 86, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 105, 114, 32, 58, 61, 32, 102, 105, 108, 101, 112, 97, 116, 104, 46, 68, 105, 114, 40, 96, 123, 123, 46, 69, 120, 112, 111, 114, 116, 125, 125, 96, 41, 10, 32, 32, 32, 32, 101, 114, 114, 32, 58, 61, 32, 111, 115, 46, 77, 107, 100, 105, 114, 65, 108, 108, 40, 100, 105, 114, 44, 32, 48, 55, 55, 55, 41, 10, 9, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 9, 9, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 9, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 114, 111, 119, 115, 44, 32, 101, 114, 114, 32, 58, 61, 32, 100, 98, 46, 81, 117, 101, 114, 121, 40, 96, 83, 69, 76, 69, 67, 84, 32, 42, 32, 70, 82, 79, 77, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 114, 111, 119, 115, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 115, 113, 108, 116, 111, 99, 115, 118, 46, 87, 114, 105, 116, 101, 70, 105, 108, 101, 40, 34, 123, 123, 46, 69, 120, 112, 111, 114, 116, 125, 125, 34, 44, 32, 114, 111, 119, 115, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 101, 120, 112, 111, 114, 116, 32, 116, 111, 32, 67, 83, 86, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 32, 114, 117, 110, 115, 32, 101, 120, 112, 111, 114, 116, 32, 111, 110, 32, 116, 104, 105, 115, 32, 118, 105, 101, 119, 32, 40, 105, 102, 32, 105, 116, 32, 104, 97, 115, 41, 10, 47, 47, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 84, 121, 112, 101, 115, 68, 97, 116, 97, 125, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 68, 114, 111, 112, 112, 105, 110, 103, 32, 116, 121, 112, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 116, 121, 112, 101, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 32, 100, 114, 111, 112, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 105, 102, 32, 105, 116, 32, 100, 111, 101, 115, 32, 110, 111, 116, 32, 101, 120, 105, 115, 116, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 40, 34, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 58, 32, 67, 114, 101, 97, 116, 105, 110, 103, 32, 116, 121, 112, 101, 46, 46, 46, 34, 41, 10, 32, 32, 32, 32, 105, 102, 32, 100, 114, 121, 82, 117, 110, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 100, 98, 44, 32, 101, 114, 114, 32, 58, 61, 32, 110, 101, 119, 68, 66, 67, 111, 110, 110, 40, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 100, 101, 102, 101, 114, 32, 100, 98, 46, 67, 108, 111, 115, 101, 40, 41, 10, 10, 32, 32, 32, 32, 95, 44, 32, 101, 114, 114, 32, 61, 32, 100, 98, 46, 69, 120, 101, 99, 40, 116, 121, 112, 101, 115, 113, 108, 46, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 70, 65, 73, 76, 69, 68, 93, 34, 41, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 102, 109, 116, 46, 80, 114, 105, 110, 116, 108, 110, 40, 34, 91, 79, 75, 93, 34, 41, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 32, 99, 114, 101, 97, 116, 101, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 102, 116, 101, 114, 10, 47, 47, 32, 101, 120, 101, 99, 117, 116, 105, 110, 103, 32, 97, 108, 108, 32, 111, 102, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 101, 110, 99, 105, 101, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 101, 112, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 10, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 65, 108, 108, 85, 112, 32, 114, 101, 99, 114, 101, 97, 116, 101, 115, 32, 97, 110, 100, 32, 102, 105, 108, 108, 32, 114, 111, 119, 115, 32, 111, 102, 32, 97, 108, 108, 32, 116, 97, 98, 108, 101, 115, 10, 102, 117, 110, 99, 32, 65, 108, 108, 85, 112, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 84, 97, 98, 108, 101, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 70, 105, 108, 108, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 67, 114, 101, 97, 116, 101, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 105, 102, 32, 46, 69, 120, 112, 111, 114, 116, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 69, 120, 112, 111, 114, 116, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10, 10, 47, 47, 32, 65, 108, 108, 68, 111, 119, 110, 32, 100, 114, 111, 112, 115, 32, 97, 108, 108, 32, 116, 97, 98, 108, 101, 115, 32, 97, 110, 100, 32, 105, 116, 115, 32, 100, 101, 112, 101, 110, 100, 97, 110, 116, 115, 10, 102, 117, 110, 99, 32, 65, 108, 108, 68, 111, 119, 110, 40, 100, 114, 121, 82, 117, 110, 32, 98, 111, 111, 108, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 68, 114, 111, 112, 68, 101, 112, 115, 65, 108, 108, 125, 125, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 58, 61, 32, 123, 123, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 40, 100, 114, 121, 82, 117, 110, 41, 59, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 32, 47, 47, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 101, 114, 114, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10})
+	box.Add("/type.go.tmpl", []byte{112, 97, 99, 107, 97, 103, 101, 32, 116, 121, 112, 101, 115, 113, 108, 10, 123, 123, 114, 97, 110, 103, 101, 32, 46, 125, 125, 10, 47, 47, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 83, 81, 76, 32, 116, 111, 32, 99, 114, 101, 97, 116, 101, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 118, 97, 114, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 61, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 83, 81, 76, 67, 114, 101, 97, 116, 101, 125, 125, 10, 10, 47, 47, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 83, 81, 76, 32, 116, 111, 32, 100, 114, 111, 112, 32, 116, 121, 112, 101, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 118, 97, 114, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 61, 32, 123, 123, 112, 114, 105, 110, 116, 102, 32, 34, 37, 113, 34, 32, 46, 83, 81, 76, 68, 114, 111, 112, 125, 125, 10, 123, 123, 101, 110, 100, 125, 125, 10})
 	box.Add("/validator.go.tmpl", []byte{47, 47, 32, 67, 111, 100, 101, 32, 103, 101, 110, 101, 114, 97, 116, 101, 100, 32, 98, 121, 32, 123, 123, 46, 71, 101, 110, 101, 114, 97, 116, 111, 114, 125, 125, 32, 68, 79, 32, 78, 79, 84, 32, 69, 68, 73, 84, 10, 10, 112, 97, 99, 107, 97, 103, 101, 32, 123, 123, 46, 80, 107, 103, 86, 97, 114, 125, 125, 10, 10, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 86, 97, 108, 105, 100, 97, 116, 105, 111, 110, 125, 125, 10, 105, 109, 112, 111, 114, 116, 32, 40, 10, 32, 32, 32, 32, 34, 102, 109, 116, 34, 10, 32, 32, 32, 32, 34, 103, 105, 116, 104, 117, 98, 46, 99, 111, 109, 47, 103, 111, 45, 111, 122, 122, 111, 47, 111, 122, 122, 111, 45, 118, 97, 108, 105, 100, 97, 116, 105, 111, 110, 47, 118, 52, 34, 10, 41, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 10, 47, 47, 32, 86, 97, 108, 105, 100, 97, 116, 111, 114, 32, 105, 109, 112, 108, 101, 109, 101, 110, 116, 115, 32, 112, 105, 112, 101, 108, 105, 110, 101, 46, 86, 97, 108, 105, 100, 97, 116, 111, 114, 32, 105, 110, 116, 101, 114, 102, 97, 99, 101, 46, 10, 116, 121, 112, 101, 32, 86, 97, 108, 105, 100, 97, 116, 111, 114, 32, 115, 116, 114, 117, 99, 116, 32, 123, 125, 10, 10, 47, 47, 32, 86, 97, 108, 105, 100, 97, 116, 101, 32, 118, 97, 108, 105, 100, 97, 116, 101, 115, 32, 102, 105, 101, 108, 100, 39, 115, 32, 118, 97, 108, 117, 101, 115, 46, 10, 102, 117, 110, 99, 32, 40, 99, 32, 86, 97, 108, 105, 100, 97, 116, 111, 114, 41, 32, 86, 97, 108, 105, 100, 97, 116, 101, 40, 102, 105, 101, 108, 100, 115, 32, 109, 97, 112, 91, 115, 116, 114, 105, 110, 103, 93, 105, 110, 116, 101, 114, 102, 97, 99, 101, 123, 125, 41, 32, 101, 114, 114, 111, 114, 32, 123, 10, 123, 123, 45, 32, 105, 102, 32, 46, 72, 97, 115, 86, 97, 108, 105, 100, 97, 116, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 118, 97, 114, 32, 101, 114, 114, 32, 101, 114, 114, 111, 114, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 70, 105, 101, 108, 100, 115, 125, 125, 10, 123, 123, 45, 32, 105, 102, 32, 46, 86, 97, 108, 105, 100, 97, 116, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 101, 114, 114, 32, 61, 32, 118, 97, 108, 105, 100, 97, 116, 105, 111, 110, 46, 86, 97, 108, 105, 100, 97, 116, 101, 40, 102, 105, 101, 108, 100, 115, 91, 34, 123, 123, 46, 78, 97, 109, 101, 125, 125, 34, 93, 44, 10, 32, 32, 32, 32, 123, 123, 45, 32, 114, 97, 110, 103, 101, 32, 46, 86, 97, 108, 105, 100, 97, 116, 105, 111, 110, 125, 125, 10, 32, 32, 32, 32, 32, 32, 32, 32, 118, 97, 108, 105, 100, 97, 116, 105, 111, 110, 46, 123, 123, 117, 112, 112, 101, 114, 67, 97, 115, 101, 70, 105, 114, 115, 116, 32, 46, 125, 125, 44, 10, 32, 32, 32, 32, 123, 123, 45, 32, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 41, 10, 32, 32, 32, 32, 105, 102, 32, 101, 114, 114, 32, 33, 61, 32, 110, 105, 108, 32, 123, 10, 32, 32, 32, 32, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 102, 109, 116, 46, 69, 114, 114, 111, 114, 102, 40, 34, 118, 97, 108, 105, 100, 97, 116, 105, 110, 103, 32, 102, 105, 101, 108, 100, 32, 39, 123, 123, 46, 78, 97, 109, 101, 125, 125, 39, 58, 32, 37, 119, 34, 44, 32, 101, 114, 114, 41, 10, 32, 32, 32, 32, 125, 10, 123, 123, 45, 32, 101, 110, 100, 125, 125, 123, 123, 101, 110, 100, 125, 125, 10, 32, 32, 32, 32, 114, 101, 116, 117, 114, 110, 32, 110, 105, 108, 10, 125, 10})
 	box.Add("/view.go.tmpl", []byte{112, 97, 99, 107, 97, 103, 101, 32, 118, 105, 101, 119, 115, 113, 108, 10, 123, 123, 114, 97, 110, 103, 101, 32, 46, 125, 125, 10, 47, 47, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 83, 81, 76, 32, 116, 111, 32, 99, 114, 101, 97, 116, 101, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 118, 97, 114, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 67, 114, 101, 97, 116, 101, 32, 61, 32, 96, 10, 67, 82, 69, 65, 84, 69, 32, 86, 73, 69, 87, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 32, 65, 83, 10, 123, 123, 46, 83, 81, 76, 32, 45, 125, 125, 10, 96, 10, 10, 47, 47, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 99, 111, 110, 116, 97, 105, 110, 115, 32, 83, 81, 76, 32, 116, 111, 32, 100, 114, 111, 112, 32, 118, 105, 101, 119, 32, 123, 123, 46, 82, 101, 102, 78, 97, 109, 101, 125, 125, 10, 118, 97, 114, 32, 123, 123, 116, 111, 69, 120, 112, 111, 114, 116, 101, 100, 78, 97, 109, 101, 32, 46, 84, 97, 114, 103, 101, 116, 78, 97, 109, 101, 125, 125, 68, 114, 111, 112, 32, 61, 32, 96, 68, 82, 79, 80, 32, 86, 73, 69, 87, 32, 73, 70, 32, 69, 88, 73, 83, 84, 83, 32, 123, 123, 46, 83, 81, 76, 70, 117, 108, 108, 78, 97, 109, 101, 125, 125, 96, 10, 123, 123, 101, 110, 100, 125, 125, 10})
 }
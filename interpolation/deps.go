@@ -19,8 +19,8 @@ type dependencyData struct {
 	Export     bool
 }
 
-func newProjectDeps(ts []*schema.Table, vs []*schema.View, nameToTarget map[string]string) (*projectDeps, error) {
-	targets := make([]string, 0, len(ts)+len(vs))
+func newProjectDeps(ts []*TableData, vs []*schema.View, tys []*schema.Type, nameToTarget map[string]string) (*projectDeps, error) {
+	targets := make([]string, 0, len(ts)+len(vs)+len(tys))
 	targetIsTable := make(map[string]bool)
 	targetHasExport := make(map[string]bool)
 	for _, s := range ts {
@@ -33,6 +33,9 @@ func newProjectDeps(ts []*schema.Table, vs []*schema.View, nameToTarget map[stri
 			targetHasExport[v.RefName] = true
 		}
 	}
+	for _, t := range tys {
+		targets = append(targets, t.RefName)
+	}
 
 	d := deps.NewGraph(targets)
 
@@ -43,6 +46,12 @@ func newProjectDeps(ts []*schema.Table, vs []*schema.View, nameToTarget map[stri
 				return nil, err
 			}
 		}
+		for _, t := range s.Types {
+			err := d.DependsOn(s.RefName, t)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	for _, s := range vs {
@@ -13,6 +13,7 @@ type Interpolator struct {
 	ImportPath string
 	TablesData []*TableData
 	ViewsData  []*ViewData
+	TypesData  []*TypeData
 	HasExport  bool
 
 	CreateDepsAll []dependencyData
@@ -25,12 +26,22 @@ type Interpolator struct {
 }
 
 // NewInterpolator returns a new interpolator
-func NewInterpolator(baseImportPath, rootDir, defaultSchema string, ts []*schema.Table, vs []*schema.View) (*Interpolator, error) {
+func NewInterpolator(baseImportPath, rootDir, defaultSchema string, ts []*schema.Table, vs []*schema.View, tys []*schema.Type) (*Interpolator, error) {
 	var err error
 
+	// enums declared in table specs are types as well
+	for _, t := range ts {
+		tys = append(tys, t.Types...)
+	}
+
+	types, err := newTypeSet(tys, defaultSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	lt := make([]*TableData, len(ts))
 	for i, t := range ts {
-		lt[i], err = newTableData(t, baseImportPath, rootDir)
+		lt[i], err = newTableData(t, baseImportPath, rootDir, types)
 		if err != nil {
 			return nil, err
 		}
@@ -41,8 +52,13 @@ func NewInterpolator(baseImportPath, rootDir, defaultSchema string, ts []*schema
 		vt[i] = &ViewData{View: v}
 	}
 
-	nameToTarget, targetToName := generateTargetNames(lt, vt)
-	pdeps, err := newProjectDeps(ts, vs, nameToTarget)
+	yt := make([]*TypeData, len(tys))
+	for i, t := range tys {
+		yt[i] = &TypeData{Type: t}
+	}
+
+	nameToTarget, targetToName := generateTargetNames(lt, vt, yt)
+	pdeps, err := newProjectDeps(lt, vs, tys, nameToTarget)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +68,7 @@ func NewInterpolator(baseImportPath, rootDir, defaultSchema string, ts []*schema
 		ImportPath:    baseImportPath,
 		TablesData:    lt,
 		ViewsData:     vt,
+		TypesData:     yt,
 		CreateDepsAll: pdeps.createAllDependenciesData(false),
 		DropDepsAll:   pdeps.createAllDependenciesData(true),
 		HasExport:     pdeps.HasExport(),
@@ -79,7 +96,7 @@ func (ip *Interpolator) Interpolate() error {
 	return nil
 }
 
-func generateTargetNames(ts []*TableData, vs []*ViewData) (map[string]string, map[string]string) {
+func generateTargetNames(ts []*TableData, vs []*ViewData, tys []*TypeData) (map[string]string, map[string]string) {
 	nameToTarget := make(map[string]string)
 	targetToName := make(map[string]string)
 	i := 0
@@ -98,6 +115,14 @@ func generateTargetNames(ts []*TableData, vs []*ViewData) (map[string]string, ma
 		nameToTarget[t.RefName] = target
 		targetToName[target] = t.RefName
 	}
+
+	for _, t := range tys {
+		i++
+		target := fmt.Sprintf("Target%03d", i)
+		t.TargetName = target
+		nameToTarget[t.RefName] = target
+		targetToName[target] = t.RefName
+	}
 	return nameToTarget, targetToName
 }
 
@@ -128,7 +153,7 @@ func (ip Interpolator) checkDuplicateName() error {
 			return fmt.Errorf("duplicate name '%s' in '%s'",
 				t.FullName, t.SpecFile)
 		}
-		m[t.Name] = true
+		m[t.FullName] = true
 	}
 	for _, v := range ip.ViewsData {
 		_, found := m[v.FullName]
@@ -136,7 +161,16 @@ func (ip Interpolator) checkDuplicateName() error {
 			return fmt.Errorf("duplicate name '%s' in '%s'",
 				v.FullName, v.SpecFile)
 		}
-		m[v.Name] = true
+		m[v.FullName] = true
+	}
+	// tables and views have types with the same name in PostgreSQL
+	for _, t := range ip.TypesData {
+		_, found := m[t.FullName]
+		if found {
+			return fmt.Errorf("duplicate name '%s' in '%s'",
+				t.FullName, t.SpecFile)
+		}
+		m[t.FullName] = true
 	}
 	return nil
 }
@@ -169,6 +203,17 @@ func (ip *Interpolator) linkDependencies() error {
 		v.CreateDepsIncludeTable = hasTableDep(v.CreateDeps)
 		v.DropDepsIncludeTable = hasTableDep(v.DropDeps)
 	}
+
+	for _, t := range ip.TypesData {
+		t.CreateDeps, err = ip.pdeps.createDependenciesData(t.RefName, false)
+		if err != nil {
+			return err
+		}
+		t.DropDeps, err = ip.pdeps.createDependenciesData(t.RefName, true)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
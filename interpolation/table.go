@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"path"
 	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/frm-adiputra/csv2postgres/common"
@@ -20,8 +22,30 @@ type TableData struct {
 	Generator string
 	SpecFile  string
 
-	DataSource     string
+	SourceFormat   string
+	DataSources    []string
+	Positional     bool
+	HasHeader      bool
+	HeaderMatch    string
+	HasOptional    bool
+	ExtraColumns   string
+	FileNameColumn string
 	CSVSeparator   string
+	SkipRows       int
+	SkipFooterRows int
+	Comment        string
+	Quote          string
+	LazyQuotes     bool
+	KeepSpace      bool
+	FlexibleCount  bool
+	Compression    string
+	Encoding       string
+	Sheet          string
+	HeaderRow      int
+	CellRange      string
+	JSONNested     string
+	Query          string
+	Columns        []string
 	ComputePackage string
 	ComputePkgVar  string
 
@@ -33,10 +57,12 @@ type TableData struct {
 	RequireSQLPkg     bool
 	RequireStrconvPkg bool
 	RequireTimePkg    bool
+	ComputerImports   []string
 	HasValidation     bool
 	HasComputed       bool
 
 	DependsOn              []string
+	Types                  []string
 	CreateDeps             []dependencyData
 	DropDeps               []dependencyData
 	CreateDepsIncludeTable bool
@@ -47,12 +73,53 @@ type TableData struct {
 type FieldData struct {
 	*schema.Field
 	GoType string
+
+	// ColumnIndex is the index of field's column in row, only used if the
+	// table has positional columns.
+	ColumnIndex int
+
+	// BaseType determines how the field's value is converted. It is either
+	// numeric, money, json, bytes, interval, array, enum or the same as the Go
+	// type for required field.
+	BaseType string
+
+	// ElemType is the base type of array field's elements.
+	ElemType string
+
+	// SQLType is the field's type in table definition.
+	SQLType string
+
+	// EnumValues are the values allowed in enum field.
+	EnumValues []string
+
+	// Precision and Scale of numeric field.
+	Precision int
+	Scale     int
+}
+
+// Columns returns the candidate columns of field, which are its column
+// followed by its aliases.
+func (f FieldData) Columns() []string {
+	return append([]string{f.Column}, f.Aliases...)
+}
+
+// IsArray returns true if the field has array type.
+func (f FieldData) IsArray() bool {
+	_, ok := schema.ArrayElemType(f.Type)
+	return ok
 }
 
 // ComputedFieldData represents interpolation result for table's compute field
 type ComputedFieldData struct {
 	*schema.ComputedField
-	GoType string
+	GoType  string
+	SQLType string
+}
+
+// IsArray returns true if the computed field has array type.
+func (f ComputedFieldData) IsArray() bool {
+	_, ok := schema.ArrayElemType(f.Type)
+	return ok
 }
 
 // ComputeFnData represents interpolation result for table's compute function
@@ -62,15 +129,15 @@ type ComputeFnData struct {
 	ReturnType   string
 }
 
-func newTableData(ts *schema.Table, baseImportPath, rootDir string) (*TableData, error) {
+func newTableData(ts *schema.Table, baseImportPath, rootDir string, types typeSet) (*TableData, error) {
 	errFmt := "fail processing '%s': %w"
 
-	fields, err := newFieldsData(ts.Fields)
+	fields, err := newFieldsData(ts.Fields, ts.HasHeader(), types, ts.SchemaName)
 	if err != nil {
 		return nil, fmt.Errorf(errFmt, ts.SpecFile, err)
 	}
 
-	computedFields, err := newComputedFieldsData(ts.ComputedFields)
+	computedFields, err := newComputedFieldsData(ts.ComputedFields, types, ts.SchemaName)
 	if err != nil {
 		return nil, fmt.Errorf(errFmt, ts.SpecFile, err)
 	}
@@ -89,8 +156,30 @@ func newTableData(ts *schema.Table, baseImportPath, rootDir string) (*TableData,
 		Generator: "github.com/frm-adiputra/csv2postgres",
 		SpecFile:  ts.SpecFile,
 
-		DataSource:     ts.CSV,
+		SourceFormat:   ts.SourceFormat(),
+		DataSources:    dataSources(ts),
+		Positional:     ts.SourceFormat() == schema.FormatFixed || !ts.HasHeader(),
+		HasHeader:      ts.HasHeader(),
+		HeaderMatch:    ts.HeaderMatch,
+		HasOptional:    hasOptional(ts.Fields),
+		ExtraColumns:   ts.ExtraColumns,
+		FileNameColumn: ts.FileNameColumn,
 		CSVSeparator:   ts.Separator,
+		SkipRows:       ts.SkipRows,
+		SkipFooterRows: ts.SkipFooterRows,
+		Comment:        charLiteral(ts.Comment),
+		Quote:          charLiteral(ts.Quote),
+		LazyQuotes:     ts.LazyQuotes,
+		KeepSpace:      ts.TrimLeadingSpace != nil && !*ts.TrimLeadingSpace,
+		FlexibleCount:  ts.StrictFieldCount != nil && !*ts.StrictFieldCount,
+		Compression:    ts.Compression,
+		Encoding:       ts.Encoding,
+		Sheet:          ts.Sheet,
+		HeaderRow:      ts.HeaderRow,
+		CellRange:      ts.CellRange,
+		JSONNested:     ts.JSONNested,
+		Query:          sqlQuery(ts),
+		Columns:        columns(ts.Fields),
 		ComputePackage: ts.ComputePackage,
 		ComputePkgVar:  path.Base(ts.ComputePackage),
 
@@ -99,10 +188,13 @@ func newTableData(ts *schema.Table, baseImportPath, rootDir string) (*TableData,
 		ComputeFns:     computeFns,
 		HasComputed:    len(computeFns) != 0,
 		Constraints:    ts.Constraints,
+		DependsOn:      ts.DependsOn,
+		Types:          usedTypes(fields, computedFields, types, ts.SchemaName),
 
 		RequireSQLPkg:     requireSQLPkg(fields),
 		RequireStrconvPkg: requireStrconvPkg(fields),
 		RequireTimePkg:    requireTimePkg(fields),
+		ComputerImports:   computerImports(computeFns),
 		HasValidation:     hasValidation(ts.Fields, ts.ComputedFields),
 	}, nil
 }
@@ -122,40 +214,171 @@ func (t TableData) PkgVar() string {
 	return strings.ToLower(t.TargetName)
 }
 
-func newFieldsData(fs []*schema.Field) ([]*FieldData, error) {
+func dataSources(ts *schema.Table) []string {
+	switch {
+	case ts.Source != "":
+		return []string{ts.Source}
+	case ts.XLSX != "":
+		return []string{ts.XLSX}
+	case ts.JSON != "":
+		return []string{ts.JSON}
+	case ts.SQLite != "":
+		return []string{ts.SQLite}
+	case ts.Parquet != "":
+		return []string{ts.Parquet}
+	case len(ts.CSVFiles) != 0:
+		return ts.CSVFiles
+	}
+	return []string{ts.CSV}
+}
+
+// hasOptional reports whether any of fields is optional.
+func hasOptional(fs []*schema.Field) bool {
+	for _, f := range fs {
+		if f.Optional {
+			return true
+		}
+	}
+	return false
+}
+
+// sqlQuery returns Go string literal of the query that reads rows from SQLite
+// source, or empty string if the source is not SQLite.
+func sqlQuery(ts *schema.Table) string {
+	switch {
+	case ts.Query != "":
+		return strconv.Quote(ts.Query)
+	case ts.SourceTable != "":
+		return strconv.Quote(`SELECT * FROM "` +
+			strings.ReplaceAll(ts.SourceTable, `"`, `""`) + `"`)
+	}
+	return ""
+}
+
+// charLiteral returns Go character literal of the first character in s, or
+// empty string if s is empty.
+func charLiteral(s string) string {
+	for _, c := range s {
+		return strconv.QuoteRuneToASCII(c)
+	}
+	return ""
+}
+
+// columns returns the distinct columns of fields in order.
+func columns(fs []*schema.Field) []string {
+	a := make([]string, 0, len(fs))
+	m := make(map[string]bool)
+	for _, f := range fs {
+		if m[f.Column] {
+			continue
+		}
+		m[f.Column] = true
+		a = append(a, f.Column)
+	}
+	return a
+}
+
+func newFieldsData(fs []*schema.Field, hasHeader bool, types typeSet, schemaName string) ([]*FieldData, error) {
 	a := make([]*FieldData, len(fs))
 
 	for i, f := range fs {
-		t, err := goType(f.Type, f.Required)
+		t, sqlType, enum, err := resolveType(f.Type, f.Required, types, schemaName)
+		if err != nil {
+			return nil, err
+		}
+		idx := i
+		if !hasHeader {
+			n, err := strconv.Atoi(f.Column)
+			if err != nil {
+				return nil, err
+			}
+			idx = n - 1
+		}
+		if f.DecodeJSON {
+			t = "utils.JSON"
+		}
+		elemType := ""
+		if et, ok := schema.ArrayElemType(f.Type); ok {
+			elemType = baseType(et)
+		}
+		precision, scale, err := schema.ParseNumericType(f.Type)
 		if err != nil {
 			return nil, err
 		}
 		a[i] = &FieldData{
-			Field:  f,
-			GoType: t,
+			Field:       f,
+			GoType:      t,
+			ColumnIndex: idx,
+			BaseType:    baseType(f.Type),
+			ElemType:    elemType,
+			Precision:   precision,
+			Scale:       scale,
+			SQLType:     sqlType,
+		}
+		if enum != nil {
+			a[i].BaseType = "enum"
+			a[i].EnumValues = enum.Enum
 		}
 	}
 
 	return a, nil
 }
 
-func newComputedFieldsData(fs []*schema.ComputedField) ([]*ComputedFieldData, error) {
+func newComputedFieldsData(fs []*schema.ComputedField, types typeSet, schemaName string) ([]*ComputedFieldData, error) {
 	a := make([]*ComputedFieldData, len(fs))
 
 	for i, f := range fs {
-		t, err := goType(f.Type, f.Required)
+		t, sqlType, _, err := resolveType(f.Type, f.Required, types, schemaName)
 		if err != nil {
 			return nil, err
 		}
 		a[i] = &ComputedFieldData{
 			ComputedField: f,
 			GoType:        t,
+			SQLType:       sqlType,
 		}
 	}
 
 	return a, nil
 }
 
+// resolveType returns the Go type and the SQL type of field type t. If t
+// refers to an enum type, the enum type is also returned.
+func resolveType(t string, required bool, types typeSet, schemaName string) (string, string, *schema.Type, error) {
+	enum, ok := types.lookup(t, schemaName)
+	if ok {
+		// enum values are passed as text
+		gt, err := goType("text", required)
+		return gt, enum.SQLFullName, enum, err
+	}
+
+	gt, err := goType(t, required)
+	if err != nil {
+		return "", "", nil, err
+	}
+	return gt, t, nil, nil
+}
+
+// usedTypes returns the reference names of enum types used by fields.
+func usedTypes(fs []*FieldData, cfs []*ComputedFieldData, types typeSet, schemaName string) []string {
+	a := make([]string, 0)
+	m := make(map[string]bool)
+	add := func(t string) {
+		enum, ok := types.lookup(t, schemaName)
+		if ok && !m[enum.RefName] {
+			m[enum.RefName] = true
+			a = append(a, enum.RefName)
+		}
+	}
+	for _, f := range fs {
+		add(f.Type)
+	}
+	for _, f := range cfs {
+		add(f.Type)
+	}
+	return a
+}
+
 func newComputeFnsData(fs []*FieldData, cfs []*ComputedFieldData) ([]*ComputeFnData, error) {
 	a := make([]*ComputeFnData, 0)
 	m := make(map[string]string)
@@ -201,18 +424,58 @@ func newComputeFnsData(fs []*FieldData, cfs []*ComputedFieldData) ([]*ComputeFnD
 	return a, nil
 }
 
+// baseType returns the base type of PostgreSQL field type, which determines
+// how the field's value is converted.
+func baseType(fieldType string) string {
+	if _, ok := schema.ArrayElemType(fieldType); ok {
+		return "array"
+	}
+
+	switch {
+	case strings.HasPrefix(fieldType, "numeric"), strings.HasPrefix(fieldType, "decimal"):
+		return "numeric"
+	case fieldType == "money":
+		return "money"
+	case fieldType == "json", fieldType == "jsonb":
+		return "json"
+	case fieldType == "bytea":
+		return "bytes"
+	case strings.HasPrefix(fieldType, "interval"):
+		return "interval"
+	}
+
+	t, _ := goType(fieldType, true)
+	return t
+}
+
 func goType(fieldType string, required bool) (string, error) {
+	if elemType, ok := schema.ArrayElemType(fieldType); ok {
+		// NULL array is a nil slice, so the elements use required type
+		t, err := goType(elemType, true)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + t, nil
+	}
+
 	baseType := "unknown"
 	switch {
+	case strings.HasPrefix(fieldType, "numeric"), strings.HasPrefix(fieldType, "decimal"), fieldType == "money":
+		// exact values are passed as text
+		baseType = "string"
 	case fieldType == "boolean":
 		baseType = "bool"
+	case fieldType == "bytea":
+		baseType = "bytes"
+	case strings.HasPrefix(fieldType, "interval"):
+		baseType = "interval"
 	case fieldType == "smallint", fieldType == "integer":
 		baseType = "int32"
 	case fieldType == "bigint":
 		baseType = "int64"
 	case fieldType == "double precision", fieldType == "real":
 		baseType = "float64"
-	case fieldType == "cidr", fieldType == "inet", fieldType == "json", fieldType == "macaddr", fieldType == "text", fieldType == "uuid":
+	case fieldType == "cidr", fieldType == "inet", fieldType == "json", fieldType == "jsonb", fieldType == "macaddr", fieldType == "text", fieldType == "uuid":
 		baseType = "string"
 	case fieldType == "date":
 		baseType = "time"
@@ -236,6 +499,16 @@ func goType(fieldType string, required bool) (string, error) {
 			return "bool", nil
 		}
 		return "sql.NullBool", nil
+	case "bytes":
+		if required {
+			return "[]byte", nil
+		}
+		return "utils.NullBytes", nil
+	case "interval":
+		if required {
+			return "utils.Interval", nil
+		}
+		return "utils.NullInterval", nil
 	case "float64":
 		if required {
 			return "float64", nil
@@ -275,6 +548,31 @@ func requireSQLPkg(fs []*FieldData) bool {
 	return false
 }
 
+// computerImports returns the packages of types used by compute functions.
+func computerImports(fns []*ComputeFnData) []string {
+	pkgs := map[string]string{
+		"sql.":   "database/sql",
+		"time.":  "time",
+		"utils.": "github.com/frm-adiputra/csv2postgres/utils",
+	}
+	found := make(map[string]bool)
+	for _, fn := range fns {
+		for prefix, pkg := range pkgs {
+			if strings.HasPrefix(strings.TrimPrefix(fn.ArgumentType, "[]"), prefix) ||
+				strings.HasPrefix(strings.TrimPrefix(fn.ReturnType, "[]"), prefix) {
+				found[pkg] = true
+			}
+		}
+	}
+
+	a := make([]string, 0, len(found))
+	for pkg := range found {
+		a = append(a, pkg)
+	}
+	sort.Strings(a)
+	return a
+}
+
 func requireTimePkg(fs []*FieldData) bool {
 	for _, f := range fs {
 		if f.GoType == "time.Time" {
@@ -287,7 +585,11 @@ func requireTimePkg(fs []*FieldData) bool {
 func requireStrconvPkg(fs []*FieldData) bool {
 	for _, f := range fs {
 		switch f.GoType {
-		case "bool", "float64", "int32", "int64":
+		case "bool":
+			if len(f.TrueValues) == 0 {
+				return true
+			}
+		case "float64", "int32", "int64":
 			return true
 		}
 	}
@@ -0,0 +1,86 @@
+package interpolation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/frm-adiputra/csv2postgres/schema"
+)
+
+// TypeData represents data for user-defined type
+type TypeData struct {
+	*schema.Type
+	CreateDeps []dependencyData
+	DropDeps   []dependencyData
+}
+
+// SQLCreate returns SQL to create the enum type. If the type already exists,
+// so the type can be created along with each table using it, its values are
+// compared with the spec. A type whose values differ is out of date and it
+// must be dropped and created again.
+func (t TypeData) SQLCreate() string {
+	values := make([]string, len(t.Enum))
+	for i, v := range t.Enum {
+		values[i] = quoteLiteral(v)
+	}
+	list := strings.Join(values, ", ")
+
+	return fmt.Sprintf(`DO $$
+BEGIN
+    CREATE TYPE %s AS ENUM (%s);
+EXCEPTION
+    WHEN duplicate_object THEN
+        IF ARRAY(
+            SELECT enumlabel::text FROM pg_enum
+            WHERE enumtypid = %s::regtype ORDER BY enumsortorder
+        ) <> ARRAY[%s] THEN
+            RAISE EXCEPTION 'type %s is out of date, run type %s down and up to recreate it';
+        END IF;
+END
+$$`, t.SQLFullName, list, quoteLiteral(t.SQLFullName), list, t.FullName, t.RefName)
+}
+
+// quoteLiteral returns s as SQL string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+// SQLDrop returns SQL to drop the type.
+func (t TypeData) SQLDrop() string {
+	return fmt.Sprintf("DROP TYPE IF EXISTS %s", t.SQLFullName)
+}
+
+// typeSet finds user-defined types by their names.
+type typeSet struct {
+	types         map[string]*schema.Type // keyed by full name
+	defaultSchema string
+}
+
+func newTypeSet(tys []*schema.Type, defaultSchema string) (typeSet, error) {
+	m := make(map[string]*schema.Type)
+	for _, t := range tys {
+		if _, found := m[t.FullName]; found {
+			return typeSet{}, fmt.Errorf("duplicate type name '%s' in '%s'",
+				t.FullName, t.SpecFile)
+		}
+		m[t.FullName] = t
+	}
+	return typeSet{types: m, defaultSchema: defaultSchema}, nil
+}
+
+// lookup returns the type referred by name. Unqualified name is looked up in
+// schemaName, then in the default schema.
+func (s typeSet) lookup(name, schemaName string) (*schema.Type, bool) {
+	if strings.Contains(name, ".") {
+		t, ok := s.types[name]
+		return t, ok
+	}
+
+	for _, sn := range []string{schemaName, s.defaultSchema} {
+		t, ok := s.types[sn+"."+name]
+		if ok {
+			return t, true
+		}
+	}
+	return nil, false
+}
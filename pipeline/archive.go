@@ -0,0 +1,72 @@
+package pipeline
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+// archiveSeparator separates the path of ZIP archive and the path of its
+// member in a data source path (e.g. data.zip!/2026/sales.csv).
+const archiveSeparator = "!/"
+
+// SplitArchivePath splits data source path p referring to a member of ZIP
+// archive into the archive path and the member path. ok is false if p does
+// not refer to a member of ZIP archive.
+func SplitArchivePath(p string) (archive, member string, ok bool) {
+	i := strings.Index(strings.ToLower(p), ".zip"+archiveSeparator)
+	if i < 0 {
+		return "", "", false
+	}
+	i += len(".zip")
+	return p[:i], p[i+len(archiveSeparator):], true
+}
+
+// openArchiveMember opens a member of ZIP archive for reading.
+func openArchiveMember(archive, member string) (io.ReadCloser, error) {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range zr.File {
+		if f.Name != member {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			zr.Close()
+			return nil, fmt.Errorf("%s: %w", archive, err)
+		}
+		return readCloser{Reader: rc, closers: []io.Closer{rc, zr}}, nil
+	}
+
+	zr.Close()
+	return nil, fmt.Errorf("%s: member '%s' not found", archive, member)
+}
+
+// globArchive returns the paths of ZIP archive members matching member
+// pattern, sorted by name.
+func globArchive(archive, pattern string) ([]string, error) {
+	zr, err := zip.OpenReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	var matches []string
+	for _, f := range zr.File {
+		ok, err := path.Match(pattern, f.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok && !f.FileInfo().IsDir() {
+			matches = append(matches, archive+archiveSeparator+f.Name)
+		}
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
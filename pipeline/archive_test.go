@@ -0,0 +1,91 @@
+package pipeline
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSplitArchivePath(t *testing.T) {
+	cases := []struct {
+		p       string
+		archive string
+		member  string
+		ok      bool
+	}{
+		{"data/sales.csv", "", "", false},
+		{"data/open.zip!/2026/sales.csv", "data/open.zip", "2026/sales.csv", true},
+		{"data/OPEN.ZIP!/sales.csv", "data/OPEN.ZIP", "sales.csv", true},
+	}
+
+	for _, c := range cases {
+		archive, member, ok := SplitArchivePath(c.p)
+		if archive != c.archive || member != c.member || ok != c.ok {
+			t.Errorf("different result for '%s': expected=%s,%s,%t found=%s,%s,%t",
+				c.p, c.archive, c.member, c.ok, archive, member, ok)
+		}
+	}
+}
+
+func TestCSVReaderArchive(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "open.zip")
+	f, err := os.Create(archive)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	zw := zip.NewWriter(f)
+	for name, content := range map[string]string{
+		"2026/a.csv":   "id\n1\n",
+		"2026/b.csv":   "id\n2\n",
+		"readme.txt":   "open data",
+		"2025/old.csv": "id\n0\n",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		w.Write([]byte(content))
+	}
+	zw.Close()
+	f.Close()
+
+	r := &MultiFileReader{
+		Patterns:       []string{archive + "!/2026/*.csv"},
+		NewReader:      newTestCSVReader,
+		FileNameColumn: "file",
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	expected := []string{
+		"1|" + archive + "!/2026/a.csv",
+		"2|" + archive + "!/2026/b.csv",
+	}
+	for _, exp := range expected {
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if s := strings.Join(row, "|"); s != exp {
+			t.Errorf("different row: expected=%s found=%s", exp, s)
+		}
+	}
+
+	cr := &CSVReader{FileName: archive + "!/missing.csv", Separator: ','}
+	if err := cr.Open(); err == nil {
+		t.Errorf("should error on missing member")
+		cr.Close()
+	}
+}
@@ -0,0 +1,116 @@
+package pipeline
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Supported compression of data source.
+const (
+	CompressionNone  = "none"
+	CompressionGzip  = "gzip"
+	CompressionBzip2 = "bzip2"
+	CompressionZstd  = "zstd"
+)
+
+// DetectCompression returns the compression of a file based on its extension.
+func DetectCompression(fileName string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".gz", ".gzip":
+		return CompressionGzip
+	case ".bz2", ".bzip2":
+		return CompressionBzip2
+	case ".zst", ".zstd":
+		return CompressionZstd
+	}
+	return CompressionNone
+}
+
+// readCloser combines a reader with the closers of the underlying sources.
+// Closers are called in order.
+type readCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func (r readCloser) Close() error {
+	var err error
+	for _, c := range r.closers {
+		if cerr := c.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// openFile opens a file for reading, decompresses its content and
+// transcodes it from encoding to UTF-8.
+// If rd is not nil, it is read instead of the file. If fileName is
+// StdinSource, standard input is read. If fileName refers to a member of ZIP
+// archive (see SplitArchivePath), the member is read.
+// If compression is empty, it will be detected from the file extension.
+func openFile(fileName string, rd io.Reader, compression, encoding string) (io.ReadCloser, error) {
+	var closers []io.Closer
+	if rd == nil && fileName == StdinSource {
+		rd = os.Stdin
+	}
+	if rd == nil {
+		var f io.ReadCloser
+		var err error
+		if archive, member, ok := SplitArchivePath(fileName); ok {
+			f, err = openArchiveMember(archive, member)
+		} else {
+			f, err = os.Open(fileName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		rd = f
+		closers = append(closers, f)
+	}
+
+	if compression == "" {
+		compression = DetectCompression(fileName)
+	}
+
+	r, err := decompress(rd, compression)
+	if err != nil {
+		readCloser{closers: closers}.Close()
+		return nil, fmt.Errorf("%s: %w", SourceName(fileName), err)
+	}
+	closers = append([]io.Closer{r}, closers...)
+
+	d, err := decode(r, encoding)
+	if err != nil {
+		readCloser{closers: closers}.Close()
+		return nil, fmt.Errorf("%s: %w", SourceName(fileName), err)
+	}
+	return readCloser{Reader: d, closers: closers}, nil
+}
+
+// decompress wraps r with the decompressor for compression.
+func decompress(r io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case CompressionNone:
+		return ioutil.NopCloser(r), nil
+	case CompressionGzip:
+		return gzip.NewReader(r)
+	case CompressionBzip2:
+		return ioutil.NopCloser(bzip2.NewReader(r)), nil
+	case CompressionZstd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	}
+	return nil, fmt.Errorf("unknown compression '%s'", compression)
+}
@@ -2,8 +2,9 @@ package pipeline
 
 import (
 	"encoding/csv"
+	"errors"
 	"io"
-	"os"
+	"strings"
 )
 
 // CSVReader is implementation of RowReader using CSV as data source.
@@ -11,45 +12,169 @@ type CSVReader struct {
 	FileName  string
 	Separator rune
 
-	f        *os.File
+	// Reader, if not nil, is read instead of opening FileName. FileName is
+	// then only used as the name of the source. If FileName is StdinSource,
+	// standard input is read.
+	Reader io.Reader
+
+	// Compression of the file, one of the Compression constants. If empty,
+	// it will be detected from the file extension.
+	Compression string
+
+	// Encoding is the character encoding of the file (e.g. windows-1252 or
+	// utf-16le). If empty, UTF-8 is assumed.
+	Encoding string
+
+	// NoHeader specifies that the file has no header row, so the first row
+	// is read as data.
+	NoHeader bool
+
+	// SkipRows is the number of lines skipped before the header (or the first
+	// row if NoHeader is true).
+	SkipRows int
+
+	// SkipFooterRows is the number of rows at the end of file that are
+	// skipped.
+	SkipFooterRows int
+
+	// Comment, if not 0, is the comment character. Lines beginning with it
+	// are ignored.
+	Comment rune
+
+	// Quote, if not 0, is the quote character used instead of '"'. It must be
+	// a single byte character.
+	Quote byte
+
+	// LazyQuotes allows quote to appear in unquoted field and non-doubled
+	// quote to appear in quoted field.
+	LazyQuotes bool
+
+	// KeepLeadingSpace keeps the leading white space in fields. By default it
+	// is removed.
+	KeepLeadingSpace bool
+
+	// FlexibleFieldCount allows rows to have different number of fields.
+	// Rows having less fields than the header are padded with empty fields.
+	FlexibleFieldCount bool
+
+	f        io.ReadCloser
+	lc       *lineCounter
 	rcsv     *csv.Reader
 	header   []string
+	pending  []csvRow
+	line     int64
 	rowCount int64
 }
 
+// NewCSVReaderFrom creates a CSVReader that reads comma separated rows from
+// rd. name is used as the name of the source.
+func NewCSVReaderFrom(name string, rd io.Reader) *CSVReader {
+	return &CSVReader{FileName: name, Reader: rd, Separator: ','}
+}
+
+// csvRow is a row with the line number where it starts.
+type csvRow struct {
+	fields []string
+	line   int64
+}
+
 // Open opens CSV file for reading.
 func (r *CSVReader) Open() error {
-	f, err := os.Open(r.FileName)
+	f, err := openFile(r.FileName, r.Reader, r.Compression, r.Encoding)
 	if err != nil {
 		return err
 	}
 	r.f = f
 	r.rowCount = 0
-	rcsv := csv.NewReader(f)
-	rcsv.Comma = r.Separator
-	rcsv.TrimLeadingSpace = true
-	r.rcsv = rcsv
+	r.line = 0
+	r.pending = nil
 
-	err = r.readHeader()
+	r.lc = newLineCounter(f)
+	err = r.lc.skipLines(r.SkipRows)
 	if err != nil {
 		return err
 	}
+
+	var cr io.Reader = r.lc
+	if r.Quote != 0 && r.Quote != '"' {
+		cr = &swapReader{r: r.lc, a: r.Quote, b: '"'}
+	}
+
+	rcsv := csv.NewReader(cr)
+	rcsv.Comma = r.Separator
+	rcsv.Comment = r.Comment
+	rcsv.LazyQuotes = r.LazyQuotes
+	rcsv.TrimLeadingSpace = !r.KeepLeadingSpace
+	if r.FlexibleFieldCount {
+		rcsv.FieldsPerRecord = -1
+	}
+	r.rcsv = rcsv
+
+	if !r.NoHeader {
+		err = r.readHeader()
+		if err != nil {
+			return err
+		}
+	} else {
+		r.header = nil
+	}
+
+	// rows are read ahead so that the footer is known before it is returned
+	for i := 0; i < r.SkipFooterRows; i++ {
+		row, err := r.read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		r.pending = append(r.pending, row)
+	}
 	return nil
 }
 
 func (r *CSVReader) readHeader() error {
-	rec, err := r.rcsv.Read()
-	if err == io.EOF {
-		return err
-	}
+	row, err := r.read()
 	if err != nil {
 		return err
 	}
 
-	r.header = rec
+	r.header = row.fields
 	return nil
 }
 
+// read reads a single row from CSV reader along with its starting line.
+func (r *CSVReader) read() (csvRow, error) {
+	a, err := r.rcsv.Read()
+	if err != nil {
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			// line numbers of csv.Reader does not include skipped lines
+			pe.StartLine += r.SkipRows
+			pe.Line += r.SkipRows
+		}
+		r.line = r.lc.Line()
+		return csvRow{}, err
+	}
+
+	// the row ends at the current line, and may contain line breaks in its
+	// quoted fields
+	line := r.lc.Line()
+	for i, v := range a {
+		line -= int64(strings.Count(v, "\n"))
+		if r.Quote != 0 && r.Quote != '"' {
+			a[i] = swapBytes(v, r.Quote, '"')
+		}
+	}
+
+	if r.FlexibleFieldCount {
+		for len(a) < len(r.header) {
+			a = append(a, "")
+		}
+	}
+	return csvRow{fields: a, line: line}, nil
+}
+
 // HeaderRow returns header row.
 func (r *CSVReader) HeaderRow() []string { return r.header }
 
@@ -60,16 +185,63 @@ func (r *CSVReader) Close() error {
 
 // ReadRow reads a single row
 func (r *CSVReader) ReadRow() ([]string, error) {
-	a, err := r.rcsv.Read()
+	row, err := r.read()
 	if err != nil {
 		return nil, err
 	}
+
+	if r.SkipFooterRows > 0 {
+		r.pending = append(r.pending, row)
+		row, r.pending = r.pending[0], r.pending[1:]
+	}
+
+	r.line = row.line
 	r.rowCount++
-	return a, nil
+	return row.fields, nil
 }
 
 // RowCount returns the number of rows read.
 func (r *CSVReader) RowCount() int64 { return r.rowCount }
 
+// RecordNum returns the line number where the last row read starts.
+func (r *CSVReader) RecordNum() int64 { return r.line }
+
 // Source returns the source of rows.
-func (r *CSVReader) Source() string { return r.FileName }
+func (r *CSVReader) Source() string { return SourceName(r.FileName) }
+
+// swapReader swaps two bytes in the content of underlying reader. It is used
+// to let csv.Reader parse CSV with quote character other than '"'.
+type swapReader struct {
+	r    io.Reader
+	a, b byte
+}
+
+func (s *swapReader) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	for i := 0; i < n; i++ {
+		switch p[i] {
+		case s.a:
+			p[i] = s.b
+		case s.b:
+			p[i] = s.a
+		}
+	}
+	return n, err
+}
+
+// swapBytes swaps two bytes in s.
+func swapBytes(s string, a, b byte) string {
+	if strings.IndexByte(s, a) < 0 && strings.IndexByte(s, b) < 0 {
+		return s
+	}
+	bs := []byte(s)
+	for i, c := range bs {
+		switch c {
+		case a:
+			bs[i] = b
+		case b:
+			bs[i] = a
+		}
+	}
+	return string(bs)
+}
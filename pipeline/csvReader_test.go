@@ -0,0 +1,273 @@
+package pipeline
+
+import (
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectCompression(t *testing.T) {
+	cases := map[string]string{
+		"data.csv":      CompressionNone,
+		"data.csv.gz":   CompressionGzip,
+		"data.csv.GZ":   CompressionGzip,
+		"data.csv.bz2":  CompressionBzip2,
+		"data.csv.zst":  CompressionZstd,
+		"data.csv.zstd": CompressionZstd,
+	}
+
+	for fileName, expected := range cases {
+		found := DetectCompression(fileName)
+		if found != expected {
+			t.Errorf("different compression for '%s': expected=%s found=%s",
+				fileName, expected, found)
+		}
+	}
+}
+
+func TestCSVReaderCompressed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "data.csv.gz")
+	f, err := os.Create(fileName)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	w := gzip.NewWriter(f)
+	w.Write([]byte("a,b\n1,2\n3,4\n"))
+	w.Close()
+	f.Close()
+
+	r := &CSVReader{FileName: fileName, Separator: ','}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	if h := r.HeaderRow(); len(h) != 2 || h[0] != "a" || h[1] != "b" {
+		t.Errorf("different header: expected=[a b] found=%v", h)
+	}
+
+	for {
+		_, err := r.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+	}
+
+	if r.RowCount() != 2 {
+		t.Errorf("different row count: expected=2 found=%d", r.RowCount())
+	}
+	if r.Source() != fileName {
+		t.Errorf("different source: expected=%s found=%s", fileName, r.Source())
+	}
+}
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	fileName := filepath.Join(dir, name)
+	err := ioutil.WriteFile(fileName, []byte(content), 0666)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	return fileName
+}
+
+func TestCSVReaderNoHeader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := writeTestFile(t, dir, "data.csv", "1,2\n3,4\n")
+
+	r := &CSVReader{FileName: fileName, Separator: ',', NoHeader: true}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	if r.HeaderRow() != nil {
+		t.Errorf("header must be nil, found=%v", r.HeaderRow())
+	}
+
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	if len(row) != 2 || row[0] != "1" || row[1] != "2" {
+		t.Errorf("different row: expected=[1 2] found=%v", row)
+	}
+}
+
+func TestCSVReaderEncoding(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		name     string
+		encoding string
+		content  string
+	}{
+		{"utf8-bom.csv", "", "\xef\xbb\xbfname,city\nJosé,Málaga\n"},
+		{"windows1252.csv", "windows-1252", "name,city\nJos\xe9,M\xe1laga\n"},
+		{"utf16-bom.csv", "", "\xff\xfen\x00a\x00m\x00e\x00,\x00c\x00i\x00t\x00y\x00\n\x00" +
+			"J\x00o\x00s\x00\xe9\x00,\x00M\x00\xe1\x00l\x00a\x00g\x00a\x00\n\x00"},
+	}
+
+	for _, c := range cases {
+		fileName := writeTestFile(t, dir, c.name, c.content)
+		r := &CSVReader{FileName: fileName, Separator: ',', Encoding: c.encoding}
+		err = r.Open()
+		if err != nil {
+			t.Fatalf("%s: should not error: %s", c.name, err)
+		}
+
+		if h := r.HeaderRow(); len(h) != 2 || h[0] != "name" {
+			t.Errorf("%s: different header: expected=[name city] found=%q", c.name, h)
+		}
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("%s: should not error: %s", c.name, err)
+		}
+		if len(row) != 2 || row[0] != "José" || row[1] != "Málaga" {
+			t.Errorf("%s: different row: expected=[José Málaga] found=%q", c.name, row)
+		}
+		r.Close()
+	}
+}
+
+func TestCSVReaderSkipRows(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := writeTestFile(t, dir, "report.csv", `Sales report
+"Generated on 2026-10-18, by ""system"""
+a,b
+1,x
+# comment
+2,"multi
+line"
+
+3,y
+Total,3
+Printed,1
+`)
+
+	r := &CSVReader{
+		FileName:       fileName,
+		Separator:      ',',
+		SkipRows:       2,
+		SkipFooterRows: 2,
+		Comment:        '#',
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	if h := r.HeaderRow(); len(h) != 2 || h[0] != "a" || h[1] != "b" {
+		t.Errorf("different header: expected=[a b] found=%q", h)
+	}
+
+	expected := []string{"1", "2", "3"}
+	expectedNums := []int64{4, 6, 9}
+	for i := 0; ; i++ {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			if i != len(expected) {
+				t.Errorf("different number of rows: expected=%d found=%d",
+					len(expected), i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if row[0] != expected[i] {
+			t.Errorf("different row #%d: expected=%s found=%s", i, expected[i], row[0])
+		}
+		if r.RecordNum() != expectedNums[i] {
+			t.Errorf("different record number: expected=%d found=%d",
+				expectedNums[i], r.RecordNum())
+		}
+	}
+}
+
+func TestCSVReaderDialect(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csvreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := writeTestFile(t, dir, "dialect.csv",
+		"a;b;c\n'x;y';  say \"hi\";z\n'it''s';1\n")
+
+	r := &CSVReader{
+		FileName:           fileName,
+		Separator:          ';',
+		Quote:              '\'',
+		KeepLeadingSpace:   true,
+		FlexibleFieldCount: true,
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	expected := [][]string{
+		{"x;y", `  say "hi"`, "z"},
+		{"it's", "1", ""},
+	}
+	for _, exp := range expected {
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if strings.Join(row, "|") != strings.Join(exp, "|") {
+			t.Errorf("different row: expected=%q found=%q", exp, row)
+		}
+	}
+}
+
+func TestCSVReaderFrom(t *testing.T) {
+	r := NewCSVReaderFrom("pipe", strings.NewReader("a,b\n1,2\n"))
+	err := r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	row, err := r.ReadRow()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	if len(row) != 2 || row[0] != "1" || row[1] != "2" {
+		t.Errorf("different row: expected=[1 2] found=%v", row)
+	}
+	if r.Source() != "pipe" {
+		t.Errorf("different source: expected=pipe found=%s", r.Source())
+	}
+}
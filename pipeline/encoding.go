@@ -0,0 +1,25 @@
+package pipeline
+
+import (
+	"io"
+
+	"github.com/frm-adiputra/csv2postgres/utils"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
+)
+
+// decode wraps r with a reader that transcodes its content from the encoding
+// to UTF-8. If encoding is empty, UTF-8 is assumed. A leading byte order mark
+// is always removed, and if it is a UTF-16 byte order mark, it overrides the
+// encoding.
+func decode(r io.Reader, encoding string) (io.Reader, error) {
+	e := unicode.UTF8
+	if encoding != "" {
+		var err error
+		e, err = utils.LookupEncoding(encoding)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return transform.NewReader(r, unicode.BOMOverride(e.NewDecoder())), nil
+}
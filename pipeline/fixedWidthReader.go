@@ -0,0 +1,134 @@
+package pipeline
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// Trimming of fixed width column values.
+const (
+	TrimNone  = "none"
+	TrimLeft  = "left"
+	TrimRight = "right"
+	TrimBoth  = "both"
+)
+
+// FixedWidthColumn specifies the position of a column in fixed width line.
+type FixedWidthColumn struct {
+	// Start is the position (starting from 1) of the first character.
+	Start int
+
+	// End is the position of the last character (inclusive).
+	End int
+
+	// Trim specifies which spaces around the value are removed, one of the
+	// Trim constants. Default to TrimBoth.
+	Trim string
+}
+
+// FixedWidthReader is implementation of RowReader using fixed width text as
+// data source. Positions are counted in characters. Empty lines are skipped.
+// It has no header, so the values of a row are in the same order as Columns.
+type FixedWidthReader struct {
+	FileName string
+
+	// Reader, if not nil, is read instead of opening FileName. FileName is
+	// then only used as the name of the source. If FileName is StdinSource,
+	// standard input is read.
+	Reader io.Reader
+
+	// Compression of the file, one of the Compression constants. If empty,
+	// it will be detected from the file extension.
+	Compression string
+
+	// Encoding is the character encoding of the file (e.g. windows-1252 or
+	// utf-16le). If empty, UTF-8 is assumed.
+	Encoding string
+
+	Columns []FixedWidthColumn
+
+	f        io.ReadCloser
+	br       *bufio.Reader
+	lineNum  int64
+	rowCount int64
+}
+
+// Open opens fixed width file for reading.
+func (r *FixedWidthReader) Open() error {
+	f, err := openFile(r.FileName, r.Reader, r.Compression, r.Encoding)
+	if err != nil {
+		return err
+	}
+	r.f = f
+	r.br = bufio.NewReader(f)
+	r.lineNum = 0
+	r.rowCount = 0
+	return nil
+}
+
+// HeaderRow returns nil as fixed width file has no header.
+func (r *FixedWidthReader) HeaderRow() []string { return nil }
+
+// Close closes fixed width file.
+func (r *FixedWidthReader) Close() error {
+	return r.f.Close()
+}
+
+// ReadRow reads a single line and splits it into columns.
+func (r *FixedWidthReader) ReadRow() ([]string, error) {
+	for {
+		line, err := r.br.ReadString('\n')
+		if err == io.EOF && line == "" {
+			return nil, io.EOF
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		r.lineNum++
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			continue
+		}
+		r.rowCount++
+		return r.split([]rune(line)), nil
+	}
+}
+
+func (r *FixedWidthReader) split(line []rune) []string {
+	a := make([]string, len(r.Columns))
+	for i, c := range r.Columns {
+		start, end := c.Start-1, c.End
+		if start > len(line) {
+			start = len(line)
+		}
+		if end > len(line) {
+			end = len(line)
+		}
+		a[i] = trim(string(line[start:end]), c.Trim)
+	}
+	return a
+}
+
+func trim(s, t string) string {
+	switch t {
+	case TrimNone:
+		return s
+	case TrimLeft:
+		return strings.TrimLeftFunc(s, unicode.IsSpace)
+	case TrimRight:
+		return strings.TrimRightFunc(s, unicode.IsSpace)
+	}
+	return strings.TrimSpace(s)
+}
+
+// RowCount returns the number of rows read.
+func (r *FixedWidthReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the line number of the last row read.
+func (r *FixedWidthReader) RecordNum() int64 { return r.lineNum }
+
+// Source returns the source of rows.
+func (r *FixedWidthReader) Source() string { return SourceName(r.FileName) }
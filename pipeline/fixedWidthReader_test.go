@@ -0,0 +1,57 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestFixedWidthReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fixedwidthreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := writeTestFile(t, dir, "statement.txt",
+		"0001Budi      00150\r\n\r\n0002Ani  \n0003Ésa       00200\n")
+
+	r := &FixedWidthReader{
+		FileName: fileName,
+		Columns: []FixedWidthColumn{
+			{Start: 1, End: 4},
+			{Start: 5, End: 14, Trim: TrimNone},
+			{Start: 15, End: 19},
+		},
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	expected := []string{"0001|Budi      |00150", "0002|Ani  |", "0003|Ésa       |00200"}
+	expectedNums := []int64{1, 3, 4}
+	for i := 0; ; i++ {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			if i != len(expected) {
+				t.Errorf("different number of rows: expected=%d found=%d",
+					len(expected), i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if s := strings.Join(row, "|"); s != expected[i] {
+			t.Errorf("different row #%d: expected=%s found=%s", i, expected[i], s)
+		}
+		if r.RecordNum() != expectedNums[i] {
+			t.Errorf("different record number: expected=%d found=%d",
+				expectedNums[i], r.RecordNum())
+		}
+	}
+}
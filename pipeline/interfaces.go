@@ -12,6 +12,10 @@ type RowReader interface {
 	// RowCount returns the number of rows read.
 	RowCount() int64
 
+	// RecordNum returns the record number of the last row read within its
+	// source. It is used for reporting errors.
+	RecordNum() int64
+
 	// Source returns the source of rows.
 	Source() string
 }
@@ -21,6 +25,10 @@ type RowReader interface {
 type FieldProvider interface {
 	Setup(header []string) error
 	ProvideField([]string) (map[string]interface{}, error)
+
+	// Warnings returns the warnings found on Setup, e.g. a field read from
+	// an alias column.
+	Warnings() []string
 }
 
 // Converter is the interface that wraps the functionality of converting field
@@ -63,4 +71,12 @@ type DBSynchronizer interface {
 
 	// RowCount returns number of rows that is filled.
 	RowCount() int64
+
+	// Warnings returns the warnings found on the last Fill.
+	Warnings() []string
+
+	// SetSource sets the data source paths to fill rows from. Relative paths
+	// are resolved against dataDir. If no sources given, the sources in spec
+	// are used. It returns error if the sources cannot be read.
+	SetSource(dataDir string, sources ...string) error
 }
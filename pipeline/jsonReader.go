@@ -0,0 +1,173 @@
+package pipeline
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Handling of nested JSON values (objects and arrays) by JSONReader.
+const (
+	JSONNestedReject    = "reject"
+	JSONNestedStringify = "stringify"
+)
+
+// JSONReader is implementation of RowReader using JSON as data source. The
+// source can be either JSON Lines (one object per line) or an array of
+// objects.
+type JSONReader struct {
+	FileName string
+
+	// Reader, if not nil, is read instead of opening FileName. FileName is
+	// then only used as the name of the source. If FileName is StdinSource,
+	// standard input is read.
+	Reader io.Reader
+
+	// Compression of the file, one of the Compression constants. If empty,
+	// it will be detected from the file extension.
+	Compression string
+
+	// Columns are the paths of values read from each object. A path refers to
+	// a nested value using dots (e.g. customer.address.city). Columns is used
+	// as the header row.
+	Columns []string
+
+	// Nested specifies how nested values (objects and arrays) are handled,
+	// one of the JSONNested constants. Default to JSONNestedReject.
+	Nested string
+
+	f        io.ReadCloser
+	dec      *json.Decoder
+	isArray  bool
+	paths    [][]string
+	rowCount int64
+}
+
+// Open opens JSON file for reading.
+func (r *JSONReader) Open() error {
+	f, err := openFile(r.FileName, r.Reader, r.Compression, "")
+	if err != nil {
+		return err
+	}
+	r.f = f
+	r.rowCount = 0
+
+	r.paths = make([][]string, len(r.Columns))
+	for i, c := range r.Columns {
+		r.paths[i] = strings.Split(c, ".")
+	}
+
+	br := bufio.NewReader(f)
+	r.isArray, err = startsWithArray(br)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	r.dec = json.NewDecoder(br)
+	r.dec.UseNumber()
+	if r.isArray {
+		// consume the opening bracket
+		_, err = r.dec.Token()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startsWithArray reports whether the first non-whitespace character is '['.
+func startsWithArray(br *bufio.Reader) (bool, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return false, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b == '[', br.UnreadByte()
+	}
+}
+
+// HeaderRow returns the columns.
+func (r *JSONReader) HeaderRow() []string { return r.Columns }
+
+// Close closes JSON file.
+func (r *JSONReader) Close() error {
+	return r.f.Close()
+}
+
+// ReadRow reads a single object and returns the values of its columns.
+func (r *JSONReader) ReadRow() ([]string, error) {
+	if r.isArray && !r.dec.More() {
+		return nil, io.EOF
+	}
+
+	var obj map[string]interface{}
+	err := r.dec.Decode(&obj)
+	if err == io.EOF {
+		return nil, err
+	}
+	r.rowCount++
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([]string, len(r.paths))
+	for i, p := range r.paths {
+		a[i], err = r.value(obj, p)
+		if err != nil {
+			return nil, fmt.Errorf("column '%s': %w", r.Columns[i], err)
+		}
+	}
+	return a, nil
+}
+
+// value returns the string representation of value at path p.
+// Missing values and null are returned as empty string.
+func (r *JSONReader) value(obj map[string]interface{}, p []string) (string, error) {
+	var v interface{} = obj
+	for _, k := range p {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return "", nil
+		}
+		v = m[k]
+	}
+
+	switch t := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return t, nil
+	case json.Number:
+		return t.String(), nil
+	case bool:
+		if t {
+			return "true", nil
+		}
+		return "false", nil
+	}
+
+	if r.Nested != JSONNestedStringify {
+		return "", errors.New("nested value not allowed")
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// RowCount returns the number of rows read.
+func (r *JSONReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the record number of the last row read.
+func (r *JSONReader) RecordNum() int64 { return r.rowCount }
+
+// Source returns the source of rows.
+func (r *JSONReader) Source() string { return SourceName(r.FileName) }
@@ -0,0 +1,84 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestJSONReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "jsonreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	lines := writeTestFile(t, dir, "lines.jsonl", `{"id": 1, "customer": {"name": "A", "address": {"city": "X"}}}
+{"id": 2.50, "customer": {"name": "B"}, "active": true}
+`)
+	array := writeTestFile(t, dir, "array.json", ` [
+  {"id": 1, "customer": {"name": "A", "address": {"city": "X"}}},
+  {"id": 2.50, "customer": {"name": "B"}, "active": true}
+]`)
+	nested := writeTestFile(t, dir, "nested.jsonl", `{"id": 1, "tags": ["a", "b"]}`)
+
+	expected := []string{"1,A,X,", "2.50,B,,true"}
+	columns := []string{"id", "customer.name", "customer.address.city", "active"}
+
+	for _, fileName := range []string{lines, array} {
+		r := &JSONReader{FileName: fileName, Columns: columns}
+		err := r.Open()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+
+		for i := 0; ; i++ {
+			row, err := r.ReadRow()
+			if err == io.EOF {
+				if i != len(expected) {
+					t.Errorf("%s: different number of rows: expected=%d found=%d",
+						fileName, len(expected), i)
+				}
+				break
+			}
+			if err != nil {
+				t.Fatalf("should not error: %s", err)
+			}
+			if s := strings.Join(row, ","); s != expected[i] {
+				t.Errorf("%s: different row #%d: expected=%s found=%s",
+					fileName, i, expected[i], s)
+			}
+		}
+		r.Close()
+	}
+
+	t.Run("nested values", func(t *testing.T) {
+		r := &JSONReader{FileName: nested, Columns: []string{"tags"}}
+		if err := r.Open(); err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		defer r.Close()
+		if _, err := r.ReadRow(); err == nil {
+			t.Error("must returns error")
+		}
+
+		r = &JSONReader{
+			FileName: nested,
+			Columns:  []string{"tags"},
+			Nested:   JSONNestedStringify,
+		}
+		if err := r.Open(); err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		defer r.Close()
+		row, err := r.ReadRow()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if row[0] != `["a","b"]` {
+			t.Errorf(`different value: expected=["a","b"] found=%s`, row[0])
+		}
+	})
+}
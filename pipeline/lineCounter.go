@@ -0,0 +1,59 @@
+package pipeline
+
+import (
+	"bufio"
+	"io"
+)
+
+// lineCounter is a reader that hands out at most a single line on each read,
+// so the number of lines consumed by a buffered reader on top of it (such as
+// csv.Reader) is exactly known.
+type lineCounter struct {
+	br      *bufio.Reader
+	buf     []byte
+	line    int64
+	newLine bool
+}
+
+func newLineCounter(r io.Reader) *lineCounter {
+	return &lineCounter{br: bufio.NewReader(r), newLine: true}
+}
+
+// Read implements io.Reader.
+func (l *lineCounter) Read(p []byte) (int, error) {
+	if len(l.buf) == 0 {
+		b, err := l.br.ReadSlice('\n')
+		if len(b) == 0 {
+			return 0, err
+		}
+		l.buf = b
+		if l.newLine {
+			l.line++
+		}
+		l.newLine = b[len(b)-1] == '\n'
+	}
+
+	n := copy(p, l.buf)
+	l.buf = l.buf[n:]
+	return n, nil
+}
+
+// Line returns the number of the line being read, starting from 1.
+func (l *lineCounter) Line() int64 { return l.line }
+
+// skipLines skips n lines. It must be called before other reads.
+func (l *lineCounter) skipLines(n int) error {
+	buf := make([]byte, 4096)
+	for i := 0; i < n; i++ {
+		for {
+			_, err := l.Read(buf)
+			if err != nil {
+				return err
+			}
+			if len(l.buf) == 0 && l.newLine {
+				break
+			}
+		}
+	}
+	return nil
+}
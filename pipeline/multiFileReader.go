@@ -0,0 +1,235 @@
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// MultiFileReader is implementation of RowReader that reads rows from
+// multiple files in order, as if they are a single source. All files must
+// have the same header.
+type MultiFileReader struct {
+	// Patterns of file names. A pattern containing glob meta characters is
+	// expanded using filepath.Glob and its matches are read in lexical order.
+	// In a pattern referring to members of ZIP archive, only the member path
+	// may contain glob meta characters. Patterns are resolved against BaseDir
+	// using ResolvePath.
+	Patterns []string
+
+	// BaseDir is the directory that relative patterns are resolved against.
+	BaseDir string
+
+	// NewReader creates a RowReader for a single file.
+	NewReader func(fileName string) RowReader
+
+	// FileNameColumn, if not empty, is the name of additional column that
+	// contains the name of the file the row came from. The name is the path
+	// as written in pattern (relative to BaseDir for glob matches), so it
+	// does not depend on where the files are located.
+	FileNameColumn string
+
+	files    []sourceFile
+	current  int
+	r        RowReader
+	header   []string
+	rowCount int64
+}
+
+// Open expands the patterns and opens the first file for reading.
+func (r *MultiFileReader) Open() error {
+	files, err := expandPatterns(r.BaseDir, r.Patterns)
+	if err != nil {
+		return err
+	}
+	r.files = files
+	r.current = 0
+	r.rowCount = 0
+
+	err = r.openCurrent()
+	if err != nil {
+		return err
+	}
+
+	r.header = append([]string{}, r.r.HeaderRow()...)
+	if r.FileNameColumn != "" {
+		r.header = append(r.header, r.FileNameColumn)
+	}
+	return nil
+}
+
+// sourceFile is a file to read.
+type sourceFile struct {
+	path string // path used to open the file
+	name string // name stored in file name column
+}
+
+func expandPatterns(baseDir string, patterns []string) ([]sourceFile, error) {
+	files := make([]sourceFile, 0, len(patterns))
+	for _, p := range patterns {
+		resolved := ResolvePath(baseDir, p)
+		if !HasGlobMeta(p) {
+			files = append(files, sourceFile{path: resolved, name: p})
+			continue
+		}
+
+		var matches []string
+		var err error
+		if archive, member, ok := SplitArchivePath(resolved); ok {
+			matches, err = globArchive(archive, member)
+		} else {
+			matches, err = filepath.Glob(resolved)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match '%s'", p)
+		}
+		sort.Strings(matches)
+		for _, m := range matches {
+			files = append(files, sourceFile{path: m, name: matchName(baseDir, p, m)})
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, errors.New("no files to read")
+	}
+	return files, nil
+}
+
+// matchName returns the name of file m matching pattern p, which is m
+// relative to baseDir if p is a relative path.
+func matchName(baseDir, p, m string) string {
+	if baseDir == "" || filepath.IsAbs(os.ExpandEnv(p)) {
+		return m
+	}
+	name, err := filepath.Rel(os.ExpandEnv(baseDir), m)
+	if err != nil {
+		return m
+	}
+	return name
+}
+
+// HasGlobMeta reports whether path p contains glob meta characters.
+func HasGlobMeta(p string) bool {
+	return strings.ContainsAny(p, "*?[")
+}
+
+func (r *MultiFileReader) openCurrent() error {
+	rr := r.NewReader(r.files[r.current].path)
+	err := rr.Open()
+	if err != nil {
+		r.r = nil
+		return err
+	}
+	r.r = rr
+	return nil
+}
+
+// next closes the current file and opens the next one. It returns io.EOF if
+// there is no more file to read.
+func (r *MultiFileReader) next() error {
+	err := r.r.Close()
+	if err != nil {
+		return err
+	}
+
+	if r.current+1 >= len(r.files) {
+		r.r = nil
+		return io.EOF
+	}
+
+	r.current++
+	err = r.openCurrent()
+	if err != nil {
+		return err
+	}
+
+	h := r.r.HeaderRow()
+	first := r.header
+	if r.FileNameColumn != "" {
+		first = first[:len(first)-1]
+	}
+	if !equalHeader(first, h) {
+		return fmt.Errorf("%s: header does not match header of %s",
+			r.files[r.current].path, r.files[0].path)
+	}
+	return nil
+}
+
+func equalHeader(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HeaderRow returns header row.
+func (r *MultiFileReader) HeaderRow() []string { return r.header }
+
+// Close closes the file currently being read.
+func (r *MultiFileReader) Close() error {
+	if r.r == nil {
+		return nil
+	}
+	err := r.r.Close()
+	r.r = nil
+	return err
+}
+
+// ReadRow reads a single row, continuing to the next file when the current
+// one is exhausted.
+func (r *MultiFileReader) ReadRow() ([]string, error) {
+	if r.r == nil {
+		return nil, io.EOF
+	}
+
+	for {
+		a, err := r.r.ReadRow()
+		if err == io.EOF {
+			err = r.next()
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		r.rowCount++
+		if r.FileNameColumn != "" {
+			a = append(a, r.files[r.current].name)
+		}
+		return a, nil
+	}
+}
+
+// RowCount returns the number of rows read from all files.
+func (r *MultiFileReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the record number of the last row read within its file.
+func (r *MultiFileReader) RecordNum() int64 {
+	if r.r == nil {
+		return r.rowCount
+	}
+	return r.r.RecordNum()
+}
+
+// Source returns the name of the file currently being read.
+func (r *MultiFileReader) Source() string {
+	if len(r.files) == 0 {
+		return strings.Join(r.Patterns, ", ")
+	}
+	return r.files[r.current].path
+}
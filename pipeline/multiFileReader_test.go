@@ -0,0 +1,130 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestCSVReader(fileName string) RowReader {
+	return &CSVReader{FileName: fileName, Separator: ','}
+}
+
+func TestMultiFileReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "multifilereader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	f1 := writeTestFile(t, dir, "sales_01.csv", "a,b\n1,2\n3,4\n")
+	f2 := writeTestFile(t, dir, "sales_02.csv", "a,b\n5,6\n")
+
+	t.Run("read files in order", func(t *testing.T) {
+		r := &MultiFileReader{
+			Patterns:       []string{filepath.Join(dir, "sales_*.csv")},
+			NewReader:      newTestCSVReader,
+			FileNameColumn: "file",
+		}
+		err := r.Open()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		defer r.Close()
+
+		expectedHeader := "a,b,file"
+		if h := strings.Join(r.HeaderRow(), ","); h != expectedHeader {
+			t.Errorf("different header: expected=%s found=%s", expectedHeader, h)
+		}
+
+		expected := []string{"1,2," + f1, "3,4," + f1, "5,6," + f2}
+		for i := 0; ; i++ {
+			row, err := r.ReadRow()
+			if err == io.EOF {
+				if i != len(expected) {
+					t.Errorf("different number of rows: expected=%d found=%d",
+						len(expected), i)
+				}
+				break
+			}
+			if err != nil {
+				t.Fatalf("should not error: %s", err)
+			}
+			if s := strings.Join(row, ","); s != expected[i] {
+				t.Errorf("different row #%d: expected=%s found=%s", i, expected[i], s)
+			}
+		}
+
+		if r.RowCount() != 3 {
+			t.Errorf("different row count: expected=3 found=%d", r.RowCount())
+		}
+	})
+
+	t.Run("file name relative to base dir", func(t *testing.T) {
+		r := &MultiFileReader{
+			Patterns:       []string{"sales_02.csv", "sales_*.csv"},
+			BaseDir:        dir,
+			NewReader:      newTestCSVReader,
+			FileNameColumn: "file",
+		}
+		err := r.Open()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		defer r.Close()
+
+		expected := []string{"sales_02.csv", "sales_01.csv", "sales_01.csv", "sales_02.csv"}
+		for i := range expected {
+			row, err := r.ReadRow()
+			if err != nil {
+				t.Fatalf("should not error: %s", err)
+			}
+			if row[2] != expected[i] {
+				t.Errorf("different file name in row #%d: expected=%s found=%s",
+					i, expected[i], row[2])
+			}
+		}
+		if r.Source() != f2 {
+			t.Errorf("different source: expected=%s found=%s", f2, r.Source())
+		}
+	})
+
+	t.Run("error when header does not match", func(t *testing.T) {
+		f3 := writeTestFile(t, dir, "other.csv", "a,c\n7,8\n")
+		r := &MultiFileReader{
+			Patterns:  []string{f1, f3},
+			NewReader: newTestCSVReader,
+		}
+		err := r.Open()
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		defer r.Close()
+
+		for {
+			_, err = r.ReadRow()
+			if err != nil {
+				break
+			}
+		}
+		if err == io.EOF {
+			t.Fatal("must returns error")
+		}
+		if r.Source() != f3 {
+			t.Errorf("different source: expected=%s found=%s", f3, r.Source())
+		}
+	})
+
+	t.Run("error when no files match", func(t *testing.T) {
+		r := &MultiFileReader{
+			Patterns:  []string{filepath.Join(dir, "nothing_*.csv")},
+			NewReader: newTestCSVReader,
+		}
+		if err := r.Open(); err == nil {
+			t.Error("must returns error")
+		}
+	})
+}
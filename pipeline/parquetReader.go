@@ -0,0 +1,346 @@
+package pipeline
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// parquetBatchSize is the number of rows read at once from each column.
+const parquetBatchSize = 1000
+
+// ParquetReader is implementation of RowReader using Parquet file as data
+// source. Only columns with a single value per row (not repeated) can be
+// read. Values are returned in their canonical text form: dates as
+// 2006-01-02, timestamps as RFC 3339 and decimals with their exact scale.
+type ParquetReader struct {
+	FileName string
+
+	// Reader, if not nil, is read instead of opening FileName. FileName is
+	// then only used as the name of the source. If FileName is StdinSource,
+	// standard input is read. The whole content is read into memory.
+	Reader io.Reader
+
+	// Columns are the paths of columns read from the file. A path refers to
+	// a column nested in groups using dots (e.g. customer.address.city).
+	// Columns is used as the header row.
+	Columns []string
+
+	pf       source.ParquetFile
+	pr       *reader.ParquetReader
+	elements []*parquet.SchemaElement
+	paths    []string
+	batch    [][]interface{}
+	pos      int
+	numRows  int64
+	rowCount int64
+}
+
+// Open opens Parquet file for reading.
+func (r *ParquetReader) Open() error {
+	pf, err := r.openFile()
+	if err != nil {
+		return err
+	}
+
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	if err != nil {
+		pf.Close()
+		return fmt.Errorf("%s: %w", r.Source(), err)
+	}
+	r.pf = pf
+	r.pr = pr
+	r.numRows = pr.GetNumRows()
+	r.rowCount = 0
+	r.batch = nil
+	r.pos = 0
+
+	r.paths = make([]string, len(r.Columns))
+	r.elements = make([]*parquet.SchemaElement, len(r.Columns))
+	for i, c := range r.Columns {
+		r.paths[i] = pr.SchemaHandler.GetRootExName() + "." + c
+		inPath, err := pr.SchemaHandler.ConvertToInPathStr(r.paths[i])
+		if err != nil {
+			r.Close()
+			return fmt.Errorf("%s: column '%s' not found", r.Source(), c)
+		}
+
+		e := pr.SchemaHandler.SchemaElements[pr.SchemaHandler.MapIndex[inPath]]
+		if e.GetNumChildren() != 0 {
+			r.Close()
+			return fmt.Errorf("%s: column '%s' is not a value column", r.Source(), c)
+		}
+		r.elements[i] = e
+	}
+	return nil
+}
+
+func (r *ParquetReader) openFile() (source.ParquetFile, error) {
+	rd := r.Reader
+	if rd == nil && r.FileName == StdinSource {
+		rd = os.Stdin
+	}
+	if rd == nil {
+		return local.NewLocalFileReader(r.FileName)
+	}
+
+	// Parquet metadata is at the end of file, so it must be seekable
+	b, err := ioutil.ReadAll(rd)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", r.Source(), err)
+	}
+	return buffer.NewBufferFile(b)
+}
+
+// HeaderRow returns the columns.
+func (r *ParquetReader) HeaderRow() []string { return r.Columns }
+
+// Close closes Parquet file.
+func (r *ParquetReader) Close() error {
+	r.pr.ReadStop()
+	return r.pf.Close()
+}
+
+// ReadRow reads a single row and returns the values of its columns.
+func (r *ParquetReader) ReadRow() ([]string, error) {
+	if r.rowCount >= r.numRows {
+		return nil, io.EOF
+	}
+	if r.batch == nil || r.pos >= len(r.batch[0]) {
+		err := r.readBatch()
+		if err != nil {
+			return nil, err
+		}
+	}
+	r.rowCount++
+
+	a := make([]string, len(r.Columns))
+	for i, values := range r.batch {
+		v, err := parquetValueString(values[r.pos], r.elements[i])
+		if err != nil {
+			return nil, fmt.Errorf("column '%s': %w", r.Columns[i], err)
+		}
+		a[i] = v
+	}
+	r.pos++
+	return a, nil
+}
+
+// readBatch reads the next rows of each column.
+func (r *ParquetReader) readBatch() error {
+	n := r.numRows - r.rowCount
+	if n > parquetBatchSize {
+		n = parquetBatchSize
+	}
+
+	r.batch = make([][]interface{}, len(r.paths))
+	for i, p := range r.paths {
+		values, rls, _, err := r.pr.ReadColumnByPath(p, n)
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", r.Columns[i], err)
+		}
+		for _, rl := range rls {
+			if rl != 0 {
+				return fmt.Errorf("column '%s': repeated values not supported",
+					r.Columns[i])
+			}
+		}
+		if int64(len(values)) != n {
+			return fmt.Errorf("column '%s': expected %d values, found %d",
+				r.Columns[i], n, len(values))
+		}
+		r.batch[i] = values
+	}
+	r.pos = 0
+	return nil
+}
+
+// parquetValueString returns the string representation of value v of column
+// e. Null is returned as empty string.
+func parquetValueString(v interface{}, e *parquet.SchemaElement) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+
+	lt := e.GetLogicalType()
+	if lt == nil {
+		lt = parquet.NewLogicalType()
+	}
+	switch {
+	case lt.IsSetDATE() || e.GetConvertedType() == parquet.ConvertedType_DATE:
+		days, ok := v.(int32)
+		if !ok {
+			return "", errors.New("invalid date value")
+		}
+		return time.Unix(int64(days)*86400, 0).UTC().Format("2006-01-02"), nil
+
+	case lt.IsSetTIMESTAMP():
+		t, err := parquetTime(v, lt.GetTIMESTAMP().GetUnit())
+		if err != nil {
+			return "", err
+		}
+		if !lt.GetTIMESTAMP().GetIsAdjustedToUTC() {
+			return t.Format("2006-01-02T15:04:05.999999999"), nil
+		}
+		return t.Format(time.RFC3339Nano), nil
+
+	case e.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MILLIS:
+		t, err := parquetTime(v, &parquet.TimeUnit{MILLIS: parquet.NewMilliSeconds()})
+		if err != nil {
+			return "", err
+		}
+		return t.Format(time.RFC3339Nano), nil
+
+	case e.GetConvertedType() == parquet.ConvertedType_TIMESTAMP_MICROS:
+		t, err := parquetTime(v, &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()})
+		if err != nil {
+			return "", err
+		}
+		return t.Format(time.RFC3339Nano), nil
+
+	case lt.IsSetTIME():
+		t, err := parquetTime(v, lt.GetTIME().GetUnit())
+		if err != nil {
+			return "", err
+		}
+		return t.Format("15:04:05.999999999"), nil
+
+	case e.GetConvertedType() == parquet.ConvertedType_TIME_MILLIS:
+		t, err := parquetTime(v, &parquet.TimeUnit{MILLIS: parquet.NewMilliSeconds()})
+		if err != nil {
+			return "", err
+		}
+		return t.Format("15:04:05.999999999"), nil
+
+	case e.GetConvertedType() == parquet.ConvertedType_TIME_MICROS:
+		t, err := parquetTime(v, &parquet.TimeUnit{MICROS: parquet.NewMicroSeconds()})
+		if err != nil {
+			return "", err
+		}
+		return t.Format("15:04:05.999999999"), nil
+
+	case lt.IsSetDECIMAL() || e.GetConvertedType() == parquet.ConvertedType_DECIMAL:
+		return parquetDecimal(v, int(e.GetScale()))
+
+	case e.GetConvertedType() == parquet.ConvertedType_UINT_32:
+		if t, ok := v.(int32); ok {
+			return strconv.FormatUint(uint64(uint32(t)), 10), nil
+		}
+
+	case e.GetConvertedType() == parquet.ConvertedType_UINT_64:
+		if t, ok := v.(int64); ok {
+			return strconv.FormatUint(uint64(t), 10), nil
+		}
+	}
+
+	switch t := v.(type) {
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int32:
+		return strconv.FormatInt(int64(t), 10), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case float32:
+		return strconv.FormatFloat(float64(t), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case string:
+		if e.GetType() == parquet.Type_INT96 {
+			return parquetInt96(t)
+		}
+		return t, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// parquetTime returns the time of integer value v in unit u since
+// 1970-01-01 00:00:00 UTC.
+func parquetTime(v interface{}, u *parquet.TimeUnit) (time.Time, error) {
+	var n int64
+	switch t := v.(type) {
+	case int32:
+		n = int64(t)
+	case int64:
+		n = t
+	default:
+		return time.Time{}, errors.New("invalid time value")
+	}
+
+	switch {
+	case u.IsSetMILLIS():
+		return time.Unix(0, n*int64(time.Millisecond)).UTC(), nil
+	case u.IsSetMICROS():
+		return time.Unix(0, n*int64(time.Microsecond)).UTC(), nil
+	}
+	return time.Unix(0, n).UTC(), nil
+}
+
+// parquetInt96 returns the timestamp of INT96 value, which is the
+// nanoseconds of the day followed by the Julian day number.
+func parquetInt96(s string) (string, error) {
+	if len(s) != 12 {
+		return "", errors.New("invalid INT96 value")
+	}
+	b := []byte(s)
+	nanos := int64(binary.LittleEndian.Uint64(b[:8]))
+	days := int64(binary.LittleEndian.Uint32(b[8:]))
+
+	// 2440588 is the Julian day number of 1970-01-01
+	t := time.Unix((days-2440588)*86400, nanos).UTC()
+	return t.Format(time.RFC3339Nano), nil
+}
+
+// parquetDecimal returns the decimal string of unscaled value v.
+func parquetDecimal(v interface{}, scale int) (string, error) {
+	n := new(big.Int)
+	switch t := v.(type) {
+	case int32:
+		n.SetInt64(int64(t))
+	case int64:
+		n.SetInt64(t)
+	case string:
+		// big-endian two's complement
+		n.SetBytes([]byte(t))
+		if len(t) > 0 && t[0]&0x80 != 0 {
+			n.Sub(n, new(big.Int).Lsh(big.NewInt(1), uint(len(t)*8)))
+		}
+	default:
+		return "", errors.New("invalid decimal value")
+	}
+
+	s := n.String()
+	if scale <= 0 {
+		return s, nil
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= scale {
+		s = strings.Repeat("0", scale-len(s)+1) + s
+	}
+	return sign + s[:len(s)-scale] + "." + s[len(s)-scale:], nil
+}
+
+// RowCount returns the number of rows read.
+func (r *ParquetReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the row number of the last row read.
+func (r *ParquetReader) RecordNum() int64 { return r.rowCount }
+
+// Source returns the source of rows.
+func (r *ParquetReader) Source() string { return SourceName(r.FileName) }
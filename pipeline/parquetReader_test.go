@@ -0,0 +1,100 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+type parquetTestAddress struct {
+	City string `parquet:"name=city, type=UTF8"`
+}
+
+type parquetTestRow struct {
+	ID      int64              `parquet:"name=id, type=INT64"`
+	Name    *string            `parquet:"name=name, type=UTF8, repetitiontype=OPTIONAL"`
+	Day     int32              `parquet:"name=day, type=DATE"`
+	Price   int64              `parquet:"name=price, type=DECIMAL, scale=2, precision=18, basetype=INT64"`
+	Balance string             `parquet:"name=balance, type=DECIMAL, scale=3, precision=20, basetype=BYTE_ARRAY"`
+	Address parquetTestAddress `parquet:"name=address"`
+}
+
+func TestParquetReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "parquetreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "data.parquet")
+	fw, err := local.NewLocalFileWriter(fileName)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	pw, err := writer.NewParquetWriter(fw, new(parquetTestRow), 1)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+
+	name := "Ana"
+	n := parquetBatchSize + 2
+	for i := 0; i < n; i++ {
+		row := parquetTestRow{
+			ID:      int64(i + 1),
+			Day:     20744, // 2026-10-18
+			Price:   -5,
+			Balance: "\xff\x85", // -123
+			Address: parquetTestAddress{City: "Lima"},
+		}
+		if i == 0 {
+			row.Name = &name
+		}
+		if err := pw.Write(row); err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+	}
+	if err := pw.WriteStop(); err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	fw.Close()
+
+	r := &ParquetReader{
+		FileName: fileName,
+		Columns:  []string{"id", "name", "day", "price", "balance", "address.city"},
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	var rows []string
+	for {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		rows = append(rows, strings.Join(row, "|"))
+	}
+
+	if len(rows) != n {
+		t.Fatalf("different number of rows: expected=%d found=%d", n, len(rows))
+	}
+	expected := "1|Ana|2026-10-18|-0.05|-0.123|Lima"
+	if rows[0] != expected {
+		t.Errorf("different first row: expected=%s found=%s", expected, rows[0])
+	}
+	expected = "1002||2026-10-18|-0.05|-0.123|Lima"
+	if rows[n-1] != expected {
+		t.Errorf("different last row: expected=%s found=%s", expected, rows[n-1])
+	}
+}
@@ -28,6 +28,11 @@ func (r *RecordReader) Open() error {
 	return nil
 }
 
+// Warnings returns the warnings found on Open.
+func (r *RecordReader) Warnings() []string {
+	return r.FieldProvider.Warnings()
+}
+
 // Close closes the record reader, no further reading allowed.
 func (r *RecordReader) Close() error {
 	return r.RowReader.Close()
@@ -39,32 +44,33 @@ func (r *RecordReader) ReadRecord() (map[string]interface{}, error) {
 	if err == io.EOF {
 		return nil, err
 	} else if err != nil {
-		return nil, fmt.Errorf("%s record #%d: %w",
-			r.Name, r.RowReader.RowCount(), err)
+		return nil, r.recordError(err)
 	}
 
 	fields, err := r.FieldProvider.ProvideField(row)
 	if err != nil {
-		return nil, fmt.Errorf("%s record #%d: %w",
-			r.Name, r.RowReader.RowCount(), err)
+		return nil, r.recordError(err)
 	}
 
 	fields, err = r.Converter.Convert(fields)
 	if err != nil {
-		return nil, fmt.Errorf("%s record #%d: %w",
-			r.Name, r.RowReader.RowCount(), err)
+		return nil, r.recordError(err)
 	}
 
 	fields, err = r.Computer.Compute(fields)
 	if err != nil {
-		return nil, fmt.Errorf("%s record #%d: %w",
-			r.Name, r.RowReader.RowCount(), err)
+		return nil, r.recordError(err)
 	}
 
 	err = r.Validator.Validate(fields)
 	if err != nil {
-		return nil, fmt.Errorf("%s record #%d: %w",
-			r.Name, r.RowReader.RowCount(), err)
+		return nil, r.recordError(err)
 	}
 	return fields, nil
 }
+
+// recordError adds the position of the last row read to err.
+func (r *RecordReader) recordError(err error) error {
+	return fmt.Errorf("%s record #%d in %s: %w",
+		r.Name, r.RowReader.RecordNum(), r.RowReader.Source(), err)
+}
@@ -0,0 +1,46 @@
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// StdinSource is the data source path that refers to standard input.
+const StdinSource = "-"
+
+// SourceName returns the name of data source path p used in messages.
+func SourceName(p string) string {
+	if p == StdinSource {
+		return "stdin"
+	}
+	return p
+}
+
+// ResolvePath expands environment variables (${VAR} or $VAR) in p, then
+// joins it with baseDir if it is a relative path. If baseDir is empty,
+// relative path is resolved against the working directory. StdinSource is
+// returned as is.
+func ResolvePath(baseDir, p string) string {
+	p = os.ExpandEnv(p)
+	if baseDir == "" || p == StdinSource || filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(os.ExpandEnv(baseDir), p)
+}
+
+// NewFilesReader returns a RowReader that reads files matching patterns,
+// which are resolved against baseDir. If there is only a single file name
+// without glob meta characters and no fileNameColumn, the RowReader for that
+// file is returned. Otherwise it returns a MultiFileReader.
+func NewFilesReader(baseDir string, patterns []string, fileNameColumn string, newReader func(fileName string) RowReader) RowReader {
+	if len(patterns) == 1 && fileNameColumn == "" &&
+		!HasGlobMeta(patterns[0]) {
+		return newReader(ResolvePath(baseDir, patterns[0]))
+	}
+	return &MultiFileReader{
+		Patterns:       patterns,
+		BaseDir:        baseDir,
+		NewReader:      newReader,
+		FileNameColumn: fileNameColumn,
+	}
+}
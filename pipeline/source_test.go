@@ -0,0 +1,31 @@
+package pipeline
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolvePath(t *testing.T) {
+	os.Setenv("CSV2PG_TEST_DIR", "/data")
+	defer os.Unsetenv("CSV2PG_TEST_DIR")
+
+	cases := []struct {
+		baseDir  string
+		path     string
+		expected string
+	}{
+		{"", "sales.csv", "sales.csv"},
+		{"in", "sales.csv", filepath.Join("in", "sales.csv")},
+		{"in", "/tmp/sales.csv", "/tmp/sales.csv"},
+		{"in", "${CSV2PG_TEST_DIR}/sales.csv", "/data/sales.csv"},
+	}
+
+	for _, c := range cases {
+		found := ResolvePath(c.baseDir, c.path)
+		if found != c.expected {
+			t.Errorf("different path for '%s': expected=%s found=%s",
+				c.path, c.expected, found)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package pipeline
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// SQLReader is implementation of RowReader using the result of a query to a
+// database (e.g. a SQLite file) as data source. The column names of the
+// result are used as the header row. The driver must be registered by
+// importing its package.
+type SQLReader struct {
+	// DriverName is the name of database/sql driver (e.g. sqlite3).
+	DriverName string
+
+	// DataSourceName is the driver specific data source name.
+	DataSourceName string
+
+	// Name is the name of the source used in messages. If empty,
+	// DataSourceName is used.
+	Name string
+
+	// Query is the query that returns the rows.
+	Query string
+
+	db       *sql.DB
+	rows     *sql.Rows
+	header   []string
+	values   []interface{}
+	rowCount int64
+}
+
+// SQLiteDataSourceName returns the data source name to open SQLite file
+// fileName read-only. The path is escaped, and a relative path is kept
+// relative to the working directory.
+func SQLiteDataSourceName(fileName string) string {
+	return "file:" + (&url.URL{Path: fileName}).EscapedPath() + "?mode=ro"
+}
+
+// Open opens the database and executes the query.
+func (r *SQLReader) Open() error {
+	db, err := sql.Open(r.DriverName, r.DataSourceName)
+	if err != nil {
+		return fmt.Errorf("%s: %w", r.Source(), err)
+	}
+
+	rows, err := db.Query(r.Query)
+	if err != nil {
+		db.Close()
+		return fmt.Errorf("%s: %w", r.Source(), err)
+	}
+
+	header, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		db.Close()
+		return fmt.Errorf("%s: %w", r.Source(), err)
+	}
+
+	r.db = db
+	r.rows = rows
+	r.header = header
+	r.values = make([]interface{}, len(header))
+	r.rowCount = 0
+	return nil
+}
+
+// HeaderRow returns the column names of the query result.
+func (r *SQLReader) HeaderRow() []string { return r.header }
+
+// Close closes the query result and the database.
+func (r *SQLReader) Close() error {
+	err := r.rows.Close()
+	if cerr := r.db.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// ReadRow reads a single row and returns its values as strings. NULL is
+// returned as empty string.
+func (r *SQLReader) ReadRow() ([]string, error) {
+	if !r.rows.Next() {
+		if err := r.rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+	r.rowCount++
+
+	ptrs := make([]interface{}, len(r.values))
+	for i := range r.values {
+		ptrs[i] = &r.values[i]
+	}
+	err := r.rows.Scan(ptrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	a := make([]string, len(r.values))
+	for i, v := range r.values {
+		a[i] = sqlValueString(v)
+	}
+	return a, nil
+}
+
+// sqlValueString returns the string representation of a value scanned from
+// database.
+func sqlValueString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(t)
+	case string:
+		return t
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case time.Time:
+		return t.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprint(v)
+}
+
+// RowCount returns the number of rows read.
+func (r *SQLReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the record number of the last row read.
+func (r *SQLReader) RecordNum() int64 { return r.rowCount }
+
+// Source returns the source of rows.
+func (r *SQLReader) Source() string {
+	if r.Name != "" {
+		return r.Name
+	}
+	return r.DataSourceName
+}
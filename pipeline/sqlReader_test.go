@@ -0,0 +1,127 @@
+package pipeline
+
+import (
+	"database/sql"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLReaderSQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "data.sqlite")
+	db, err := sql.Open("sqlite3", fileName)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	_, err = db.Exec(`CREATE TABLE customers (id INTEGER, name TEXT, score REAL);
+		INSERT INTO customers VALUES (1, 'Ana', 7.5), (2, NULL, 10)`)
+	db.Close()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+
+	r := &SQLReader{
+		DriverName:     "sqlite3",
+		DataSourceName: "file:" + fileName + "?mode=ro",
+		Name:           fileName,
+		Query:          "SELECT id, name, score FROM customers ORDER BY id",
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	if h := strings.Join(r.HeaderRow(), ","); h != "id,name,score" {
+		t.Errorf("different header: expected=id,name,score found=%s", h)
+	}
+
+	expected := []string{"1|Ana|7.5", "2||10"}
+	for i := 0; ; i++ {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			if i != len(expected) {
+				t.Errorf("different number of rows: expected=%d found=%d",
+					len(expected), i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if s := strings.Join(row, "|"); s != expected[i] {
+			t.Errorf("different row #%d: expected=%s found=%s", i, expected[i], s)
+		}
+	}
+
+	if r.Source() != fileName {
+		t.Errorf("different source: expected=%s found=%s", fileName, r.Source())
+	}
+}
+
+func TestSQLiteDataSourceName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sqlreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	err = os.Chdir(dir)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.Chdir(wd)
+
+	err = os.Mkdir("data", 0755)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	db, err := sql.Open("sqlite3", filepath.Join("data", "tmp.sqlite"))
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	_, err = db.Exec(`CREATE TABLE customers (id INTEGER)`)
+	db.Close()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+
+	// relative path with characters that must be escaped in URI
+	fileName := filepath.Join("data", "my data?.sqlite")
+	err = os.Rename(filepath.Join("data", "tmp.sqlite"), fileName)
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+
+	expected := "file:data/my%20data%3F.sqlite?mode=ro"
+	if dsn := SQLiteDataSourceName(fileName); dsn != expected {
+		t.Errorf("different data source name: expected=%s found=%s", expected, dsn)
+	}
+
+	r := &SQLReader{
+		DriverName:     "sqlite3",
+		DataSourceName: SQLiteDataSourceName(fileName),
+		Name:           fileName,
+		Query:          "SELECT id FROM customers",
+	}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	r.Close()
+}
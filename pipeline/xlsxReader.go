@@ -0,0 +1,216 @@
+package pipeline
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+)
+
+// XLSXReader is implementation of RowReader using a worksheet of XLSX file as
+// data source. Empty rows are skipped.
+type XLSXReader struct {
+	FileName string
+
+	// Reader, if not nil, is read instead of opening FileName. FileName is
+	// then only used as the name of the source. If FileName is StdinSource,
+	// standard input is read.
+	Reader io.Reader
+
+	// Sheet is the name of worksheet to read. If empty, the first worksheet
+	// will be used.
+	Sheet string
+
+	// HeaderRowNum is the row number (starting from 1) of the header row. Rows
+	// before it are skipped. If zero, the first row will be used.
+	HeaderRowNum int
+
+	// CellRange limits the cells being read (e.g. "B3:F200"). The first row of
+	// the range is the header row. If the range has no row number in its end
+	// cell (e.g. "B3:F"), rows are read until the end of worksheet.
+	CellRange string
+
+	rows      *excelize.Rows
+	rowNum    int
+	headerRow int
+	firstCol  int
+	lastCol   int
+	lastRow   int
+	header    []string
+	rowCount  int64
+}
+
+// Open opens XLSX file for reading.
+func (r *XLSXReader) Open() error {
+	err := r.parseCellRange()
+	if err != nil {
+		return err
+	}
+
+	f, err := r.openFile()
+	if err != nil {
+		return err
+	}
+
+	sheet := r.Sheet
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return fmt.Errorf("%s: %w", SourceName(r.FileName), err)
+	}
+	r.rows = rows
+	r.rowNum = 0
+	r.rowCount = 0
+
+	err = r.readHeader()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *XLSXReader) openFile() (*excelize.File, error) {
+	rd := r.Reader
+	if rd == nil && r.FileName == StdinSource {
+		rd = os.Stdin
+	}
+	if rd == nil {
+		return excelize.OpenFile(r.FileName)
+	}
+	return excelize.OpenReader(rd)
+}
+
+func (r *XLSXReader) parseCellRange() error {
+	r.headerRow = r.HeaderRowNum
+	if r.headerRow == 0 {
+		r.headerRow = 1
+	}
+	r.firstCol, r.lastCol, r.lastRow = 1, 0, 0
+
+	if r.CellRange == "" {
+		return nil
+	}
+
+	a := strings.Split(r.CellRange, ":")
+	if len(a) != 2 {
+		return fmt.Errorf("invalid cell range '%s'", r.CellRange)
+	}
+
+	col, row, err := excelize.CellNameToCoordinates(a[0])
+	if err != nil {
+		return fmt.Errorf("invalid cell range '%s': %w", r.CellRange, err)
+	}
+	r.firstCol = col
+	r.headerRow = row
+
+	if !strings.ContainsAny(a[1], "0123456789") {
+		r.lastCol, err = excelize.ColumnNameToNumber(a[1])
+	} else {
+		r.lastCol, r.lastRow, err = excelize.CellNameToCoordinates(a[1])
+	}
+	if err != nil {
+		return fmt.Errorf("invalid cell range '%s': %w", r.CellRange, err)
+	}
+	if r.lastCol < r.firstCol || (r.lastRow != 0 && r.lastRow < r.headerRow) {
+		return fmt.Errorf("invalid cell range '%s'", r.CellRange)
+	}
+	return nil
+}
+
+func (r *XLSXReader) readHeader() error {
+	// Columns must be read for every row for the iterator to advance properly
+	for r.rowNum < r.headerRow {
+		if !r.rows.Next() {
+			return io.EOF
+		}
+		r.rowNum++
+
+		rec, err := r.rows.Columns()
+		if err != nil {
+			return err
+		}
+		r.header = r.cells(rec, 0)
+	}
+	return nil
+}
+
+// cells returns the cells inside the range, padded to n cells.
+func (r *XLSXReader) cells(rec []string, n int) []string {
+	if len(rec) < r.firstCol-1 {
+		rec = nil
+	} else {
+		rec = rec[r.firstCol-1:]
+	}
+	if r.lastCol != 0 && len(rec) > r.lastCol-r.firstCol+1 {
+		rec = rec[:r.lastCol-r.firstCol+1]
+	}
+	for len(rec) < n {
+		rec = append(rec, "")
+	}
+	return rec
+}
+
+// HeaderRow returns header row.
+func (r *XLSXReader) HeaderRow() []string { return r.header }
+
+// Close closes XLSX file.
+func (r *XLSXReader) Close() error {
+	r.rows = nil
+	return nil
+}
+
+// ReadRow reads a single row
+func (r *XLSXReader) ReadRow() ([]string, error) {
+	for {
+		if r.lastRow != 0 && r.rowNum >= r.lastRow {
+			return nil, io.EOF
+		}
+		if !r.rows.Next() {
+			if err := r.rows.Error(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		r.rowNum++
+
+		rec, err := r.rows.Columns()
+		if err != nil {
+			return nil, err
+		}
+
+		a := r.cells(rec, len(r.header))
+		if isEmptyRow(a) {
+			continue
+		}
+		r.rowCount++
+		return a, nil
+	}
+}
+
+func isEmptyRow(a []string) bool {
+	for _, v := range a {
+		if v != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// RowCount returns the number of rows read.
+func (r *XLSXReader) RowCount() int64 { return r.rowCount }
+
+// RecordNum returns the worksheet row number of the last row read.
+func (r *XLSXReader) RecordNum() int64 { return int64(r.rowNum) }
+
+// Source returns the source of rows.
+func (r *XLSXReader) Source() string {
+	if r.Sheet == "" {
+		return SourceName(r.FileName)
+	}
+	return fmt.Sprintf("%s[%s]", SourceName(r.FileName), r.Sheet)
+}
@@ -0,0 +1,71 @@
+package pipeline
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+)
+
+func TestXLSXReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "xlsxreader")
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	fileName := filepath.Join(dir, "data.xlsx")
+	f := excelize.NewFile()
+	f.NewSheet("Data")
+	cells := map[string]interface{}{
+		"A1": "Monthly report",
+		"B3": "a", "C3": "b", "D3": "note",
+		"B4": 1, "C4": "x",
+		"B5": 2, "C5": "y", "D5": "ignored",
+		"B7": 3,
+	}
+	for k, v := range cells {
+		f.SetCellValue("Data", k, v)
+	}
+	if err := f.SaveAs(fileName); err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+
+	r := &XLSXReader{FileName: fileName, Sheet: "Data", CellRange: "B3:C"}
+	err = r.Open()
+	if err != nil {
+		t.Fatalf("should not error: %s", err)
+	}
+	defer r.Close()
+
+	if h := strings.Join(r.HeaderRow(), ","); h != "a,b" {
+		t.Errorf("different header: expected=a,b found=%s", h)
+	}
+
+	expected := []string{"1,x", "2,y", "3,"}
+	expectedNums := []int64{4, 5, 7}
+	for i := 0; ; i++ {
+		row, err := r.ReadRow()
+		if err == io.EOF {
+			if i != len(expected) {
+				t.Errorf("different number of rows: expected=%d found=%d",
+					len(expected), i)
+			}
+			break
+		}
+		if err != nil {
+			t.Fatalf("should not error: %s", err)
+		}
+		if s := strings.Join(row, ","); s != expected[i] {
+			t.Errorf("different row #%d: expected=%s found=%s", i, expected[i], s)
+		}
+		if r.RecordNum() != expectedNums[i] {
+			t.Errorf("different record number: expected=%d found=%d",
+				expectedNums[i], r.RecordNum())
+		}
+	}
+}
@@ -14,19 +14,73 @@ import (
 type Field struct {
 	Name string
 
-	// Column is the name of column in CSV file.
+	// Column is the name of column in CSV file. If the CSV file has no header
+	// (see Header in Table), it is the column number starting from 1.
 	Column string
 
+	// Aliases are other names of column, tried in order when Column is not
+	// found in header (e.g. the column is renamed in newer files).
+	Aliases []string `yaml:",flow"`
+
 	// Type is PostgreSQL field type
 	Type string
 
 	// If Required is true then the field cannot be NULL
 	Required bool
 
+	// If Optional is true then the field's column may be absent from the
+	// header. The field is loaded as NULL and a warning is reported. It can
+	// not be used together with Required.
+	Optional bool
+
 	// Required only for time type. For info about the format,
 	// see layout in https://golang.org/pkg/time/#Parse
 	TimeFormat string `yaml:"timeFormat"`
 
+	// Start is the position (starting from 1) of the field's first character
+	// in fixed width format.
+	Start int
+
+	// Width is the number of characters of the field in fixed width format.
+	// Either Width or End must be specified.
+	Width int
+
+	// End is the position of the field's last character (inclusive) in fixed
+	// width format.
+	End int
+
+	// Trim specifies which spaces around the value are removed in fixed width
+	// format. It must be one of none, left, right or both. Default to both.
+	Trim string
+
+	// ArrayDelimiter is the string that separates the elements of array type
+	// field (e.g. text[] or integer[]) in a value. Default to ",".
+	ArrayDelimiter string `yaml:"arrayDelimiter"`
+
+	// BinaryEncoding is the encoding of bytea field's value. It must be one of
+	// hex, base64 or raw (the value's bytes as is). Default to hex, which may
+	// have a leading \x.
+	BinaryEncoding string `yaml:"binaryEncoding"`
+
+	// DurationFormat is the format of interval field's value. It must be one
+	// of go (e.g. 1h30m), iso8601 (e.g. PT45M), clock (e.g. 02:15:00) or auto
+	// (detected for each value). Default to auto.
+	DurationFormat string `yaml:"durationFormat"`
+
+	// TrueValues and FalseValues are the literals of true and false values of
+	// boolean field (e.g. [Y, Ya, Active] and [N, Tidak, Inactive]). If not
+	// specified, the literals accepted by strconv.ParseBool are used.
+	TrueValues  []string `yaml:"trueValues,flow"`
+	FalseValues []string `yaml:"falseValues,flow"`
+
+	// IgnoreCase, if true, matches TrueValues and FalseValues case
+	// insensitively.
+	IgnoreCase bool `yaml:"ignoreCase"`
+
+	// DecodeJSON, if true, passes the decoded value of json or jsonb field (as
+	// utils.JSON) to its computeFn instead of JSON text.
+	DecodeJSON bool `yaml:"decodeJSON"`
+
 	// Set to true to exclude field from data table.
 	// Set this to true if you need this field only for reference in
 	// computedFields
@@ -64,8 +118,130 @@ type ComputedField struct {
 type Table struct {
 	*common.Names `yaml:"-"`
 	SpecFile      string `yaml:"-"`
-	CSV           string `yaml:"csv"`
-	Separator     string
+
+	// Format is the format of data source specified in Source. It must be one
+	// of csv, xlsx, json, fixed (fixed width text), sqlite or parquet.
+	// Default to csv.
+	Format string
+
+	// Source is the path of data source. As an alternative, the path can be
+	// specified using the key of its format (csv, xlsx, json, sqlite or
+	// parquet).
+	Source string
+
+	// CSV is the path of CSV file. It may be a glob pattern (e.g.
+	// sales_2026-*.csv) to read multiple files with the same header into the
+	// table. A member of ZIP archive is referred using "!/" after the archive
+	// path (e.g. opendata.zip!/2026/sales.csv).
+	CSV string `yaml:"csv"`
+
+	// CSVFiles is a list of CSV file paths (or glob patterns) to be read in
+	// order. It can not be used together with CSV.
+	CSVFiles []string `yaml:"csvFiles,flow"`
+
+	// FileNameColumn, if set, adds a column with this name to the CSV header.
+	// Its value is the name of the file the row came from, so it can be used
+	// as a field's column.
+	FileNameColumn string `yaml:"fileNameColumn"`
+
+	Separator string
+
+	// Header specifies whether the first row of CSV file is a header. Default
+	// to true. If false, field's column must be a column number.
+	Header *bool
+
+	// HeaderMatch specifies how field's column is matched against the names
+	// in header row. It must be one of exact, trimmed (ignoring surrounding
+	// spaces), case-insensitive or normalized (comparing names in snake case,
+	// e.g. " Customer ID" matches customer_id). Default to exact.
+	HeaderMatch string `yaml:"headerMatch"`
+
+	// ExtraColumns specifies what to do with header columns not used by any
+	// field. It must be one of ignore, warn or error. If warn or error, the
+	// new columns are reported along with the vanished optional columns.
+	// Default to ignore.
+	ExtraColumns string `yaml:"extraColumns"`
+
+	// SkipRows is the number of lines skipped before the header of CSV file
+	// (e.g. report titles).
+	SkipRows int `yaml:"skipRows"`
+
+	// SkipFooterRows is the number of rows at the end of CSV file that are
+	// skipped (e.g. total rows).
+	SkipFooterRows int `yaml:"skipFooterRows"`
+
+	// Comment is the comment character of CSV file. Lines beginning with it
+	// are ignored.
+	Comment string
+
+	// Quote is the quote character of CSV file. Default to '"'.
+	Quote string
+
+	// LazyQuotes allows quote to appear in unquoted field and non-doubled
+	// quote to appear in quoted field of CSV file.
+	LazyQuotes bool `yaml:"lazyQuotes"`
+
+	// TrimLeadingSpace specifies whether leading white spaces in CSV fields
+	// are removed. Default to true.
+	TrimLeadingSpace *bool `yaml:"trimLeadingSpace"`
+
+	// StrictFieldCount specifies whether all rows of CSV file must have the
+	// same number of fields. If false, rows having less fields than the
+	// header are padded with empty fields. Default to true.
+	StrictFieldCount *bool `yaml:"strictFieldCount"`
+
+	// XLSX is the path of XLSX file used as data source instead of CSV.
+	XLSX string `yaml:"xlsx"`
+
+	// Sheet is the name of worksheet in XLSX file. If not specified, the first
+	// worksheet will be used.
+	Sheet string
+
+	// HeaderRow is the row number (starting from 1) of the header in XLSX
+	// worksheet. Rows above it are skipped. Default to 1.
+	HeaderRow int `yaml:"headerRow"`
+
+	// CellRange limits the cells read from XLSX worksheet (e.g. B3:F200 or
+	// B3:F). The first row of the range is the header row.
+	CellRange string `yaml:"cellRange"`
+
+	// JSON is the path of JSON file used as data source instead of CSV. The
+	// file can be either JSON Lines or an array of objects. Field's column is
+	// the path of value in object, using dots for nested value (e.g.
+	// customer.address.city).
+	JSON string `yaml:"json"`
+
+	// JSONNested specifies how nested JSON values (objects and arrays) are
+	// handled. It must be either reject or stringify. Default to reject.
+	JSONNested string `yaml:"jsonNested"`
+
+	// SQLite is the path of SQLite database file used as data source instead
+	// of CSV. The rows are read using Query or from SourceTable. Field's column
+	// is the name of column in the query result.
+	SQLite string `yaml:"sqlite"`
+
+	// Query is the SQL query that returns the rows of SQLite source.
+	Query string
+
+	// SourceTable is the name of table in SQLite source whose rows are read.
+	// It is an alternative to Query.
+	SourceTable string `yaml:"sourceTable"`
+
+	// Parquet is the path of Parquet file used as data source instead of CSV.
+	// Field's column is the path of column, using dots for column nested in
+	// groups (e.g. customer.address.city). Repeated columns are not supported.
+	Parquet string `yaml:"parquet"`
+
+	// Compression of the CSV, JSON or fixed width file. It must be one of
+	// none, gzip, bzip2 or zstd. If not specified, it will be detected from
+	// the file extension (.gz, .bz2 or .zst) when reading. It can not be used
+	// with xlsx, sqlite or parquet format.
+	Compression string
+
+	// Encoding is the character encoding of CSV or fixed width file (e.g.
+	// windows-1252, iso-8859-1 or utf-16le). Default to UTF-8. A leading
+	// byte order mark is always removed.
+	Encoding string
 
 	// Fields defined here will be included in data table.
 	// Fields not defined here will NOT included in data table.
@@ -78,6 +254,13 @@ type Table struct {
 
 	ComputedFields []*ComputedField `yaml:"computedFields,flow"`
 
+	// Enums declares enum types that can be used as the type of fields in any
+	// spec. The types are created in the table's schema before the table.
+	Enums []*Enum `yaml:",flow"`
+
+	// Types are the types of enums declared in Enums.
+	Types []*Type `yaml:"-"`
+
 	// DependsOn specifies other spec name that the table in this spec depends
 	// on.
 	DependsOn []string `yaml:"dependsOn,flow"`
@@ -87,6 +270,41 @@ type Table struct {
 	Constraints []string `yaml:",flow"`
 }
 
+// Data source formats of table.
+const (
+	FormatCSV     = "csv"
+	FormatXLSX    = "xlsx"
+	FormatJSON    = "json"
+	FormatFixed   = "fixed"
+	FormatSQLite  = "sqlite"
+	FormatParquet = "parquet"
+)
+
+// SourceFormat returns the format of table's data source.
+func (s *Table) SourceFormat() string {
+	if s.Format != "" {
+		return s.Format
+	}
+	if s.XLSX != "" {
+		return FormatXLSX
+	}
+	if s.JSON != "" {
+		return FormatJSON
+	}
+	if s.SQLite != "" {
+		return FormatSQLite
+	}
+	if s.Parquet != "" {
+		return FormatParquet
+	}
+	return FormatCSV
+}
+
+// HasHeader reports whether the data source has a header row.
+func (s *Table) HasHeader() bool {
+	return s.Header == nil || *s.Header
+}
+
 // NewTable creates a new table spec from a YAML file.
 func NewTable(specFile, defaultSchema string) (*Table, error) {
 	f, err := os.Open(specFile)
@@ -113,5 +331,10 @@ func NewTable(specFile, defaultSchema string) (*Table, error) {
 		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
 	}
 
+	t.Types, err = t.enumTypes()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
+	}
+
 	return t, nil
 }
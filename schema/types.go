@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/frm-adiputra/csv2postgres/common"
+	"github.com/goccy/go-yaml"
+)
+
+// maxEnumLabelLen is the maximum length of enum value in bytes (NAMEDATALEN - 1
+// in PostgreSQL).
+const maxEnumLabelLen = 63
+
+// typeNamePattern matches the name of user-defined type, optionally qualified
+// by its schema name.
+var typeNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// Type specifies a user-defined database type. Currently, only enum types are
+// supported.
+type Type struct {
+	*common.Names `yaml:"-"`
+	SpecFile      string `yaml:"-"`
+
+	// Enum is the list of enum type's values in their sort order.
+	Enum []string `yaml:",flow"`
+}
+
+// Enum specifies an enum type declared in table spec.
+type Enum struct {
+	// Name of the enum type. The type is created in the table's schema.
+	Name string
+
+	// Values of the enum type in their sort order.
+	Values []string `yaml:",flow"`
+}
+
+// NewType creates a new type spec from a YAML file.
+func NewType(specFile, defaultSchema string) (*Type, error) {
+	f, err := os.Open(specFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
+	}
+	defer f.Close()
+
+	t := &Type{}
+	d := yaml.NewDecoder(f)
+	err = d.Decode(t)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
+	}
+
+	t.SpecFile = specFile
+	t.Names, err = common.NewNames(specFile, defaultSchema)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
+	}
+
+	err = validateEnumValues(t.Enum)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", specFile, err.Error())
+	}
+
+	return t, nil
+}
+
+// enumTypes returns the types of enums declared in table spec. Their names
+// are qualified in the same way as the table's name.
+func (s *Table) enumTypes() ([]*Type, error) {
+	a := make([]*Type, len(s.Enums))
+	for i, e := range s.Enums {
+		if !typeNamePattern.MatchString(e.Name) || strings.Contains(e.Name, ".") {
+			return nil, fmt.Errorf("invalid enum name '%s'", e.Name)
+		}
+
+		err := validateEnumValues(e.Values)
+		if err != nil {
+			return nil, fmt.Errorf("validating enum '%s': %w", e.Name, err)
+		}
+
+		// names are created as if the enum is declared in types directory
+		fileName := e.Name + ".yaml"
+		if s.RefName != s.Name {
+			fileName = s.SchemaName + "." + fileName
+		}
+		names, err := common.NewNames(fileName, s.SchemaName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid enum name '%s'", e.Name)
+		}
+
+		a[i] = &Type{
+			Names:    names,
+			SpecFile: s.SpecFile,
+			Enum:     e.Values,
+		}
+	}
+	return a, nil
+}
+
+func validateEnumValues(values []string) error {
+	if len(values) == 0 {
+		return errors.New("enum cannot be empty")
+	}
+
+	m := make(map[string]bool)
+	for _, v := range values {
+		if v == "" {
+			return errors.New("enum value cannot be empty")
+		}
+		if len(v) > maxEnumLabelLen {
+			return fmt.Errorf("enum value '%s' is longer than %d bytes", v, maxEnumLabelLen)
+		}
+		if m[v] {
+			return fmt.Errorf("duplicate enum value '%s'", v)
+		}
+		m[v] = true
+	}
+	return nil
+}
+
+// isTypeName returns true if t may refer to a user-defined type.
+func isTypeName(t string) bool {
+	return typeNamePattern.MatchString(t)
+}
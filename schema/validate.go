@@ -3,12 +3,25 @@ package schema
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/frm-adiputra/csv2postgres/utils"
 )
 
 var (
-	errSeparator = errors.New("separator must be a single character")
-	fieldGoTypes = []string{
+	errSeparator    = errors.New("separator must be a single character")
+	errComment      = errors.New("comment must be a single character")
+	compressions    = []string{"none", "gzip", "bzip2", "zstd"}
+	jsonNested      = []string{"reject", "stringify"}
+	formats         = []string{"csv", "xlsx", "json", "fixed", "sqlite", "parquet"}
+	trims           = []string{"none", "left", "right", "both"}
+	headerMatch     = []string{"exact", "trimmed", "case-insensitive", "normalized"}
+	extraColumns    = []string{"ignore", "warn", "error"}
+	binaryEncodings = []string{"hex", "base64", "raw"}
+	durationFormats = []string{"auto", "go", "iso8601", "clock"}
+	fieldGoTypes    = []string{
 		"bool",
 		"float64",
 		"int32",
@@ -19,13 +32,16 @@ var (
 	fieldTypes = []string{
 		"bigint",
 		"boolean",
+		"bytea",
 		"cidr",
 		"date",
 		"double precision",
 		"inet",
 		"integer",
 		"json",
+		"jsonb",
 		"macaddr",
+		"money",
 		"real",
 		"smallint",
 		"text",
@@ -35,6 +51,9 @@ var (
 		"bit",       // bit [ (n) ] | bit varying [ (n) ]
 		"character", // character [ (n) ] | character varying [ (n) ]
 		"char",      // char [ (n) ]
+		"decimal",   // decimal [ (p [, s]) ]
+		"interval",  // interval [ fields ] [ (p) ]
+		"numeric",   // numeric [ (p [, s]) ]
 		"varchar",   // varchar [ (n) ]
 		"time",      // time [ (p) ] [ without time zone ] | time [ (p) ] with time zone
 		"timestamp", // timestamp [ (p) ] [ without time zone ] | timestamp [ (p) ] with time zone
@@ -42,8 +61,60 @@ var (
 )
 
 func (s *Table) validate() error {
-	if len(s.Separator) != 1 {
-		return errSeparator
+	err := s.validateSource()
+	if err != nil {
+		return err
+	}
+
+	switch s.SourceFormat() {
+	case FormatCSV:
+		err := s.validateCSV()
+		if err != nil {
+			return err
+		}
+	case FormatXLSX:
+		err := s.validateXLSX()
+		if err != nil {
+			return err
+		}
+		if s.Compression != "" {
+			return errors.New("compression can not be used with xlsx format")
+		}
+	case FormatJSON:
+		if s.JSONNested != "" && !contains(jsonNested, s.JSONNested) {
+			return fmt.Errorf("invalid jsonNested '%s'", s.JSONNested)
+		}
+	case FormatSQLite:
+		if (s.Query == "") == (s.SourceTable == "") {
+			return errors.New("either query or sourceTable must be specified for sqlite format")
+		}
+		if s.Compression != "" {
+			return errors.New("compression can not be used with sqlite format")
+		}
+	case FormatParquet:
+		if s.Compression != "" {
+			return errors.New("compression can not be used with parquet format")
+		}
+	}
+
+	if s.SourceFormat() != FormatSQLite && (s.Query != "" || s.SourceTable != "") {
+		return errors.New("query and sourceTable can only be used with sqlite format")
+	}
+
+	if s.Compression != "" && !contains(compressions, s.Compression) {
+		return fmt.Errorf("invalid compression '%s'", s.Compression)
+	}
+
+	if s.Encoding != "" {
+		f := s.SourceFormat()
+		if f != FormatCSV && f != FormatFixed {
+			return errors.New("encoding can only be used with csv or fixed format")
+		}
+
+		_, err := utils.LookupEncoding(s.Encoding)
+		if err != nil {
+			return err
+		}
 	}
 
 	for _, f := range s.Fields {
@@ -51,6 +122,20 @@ func (s *Table) validate() error {
 		if err != nil {
 			return err
 		}
+
+		err = validateFieldPosition(f, s.SourceFormat() == FormatFixed)
+		if err != nil {
+			return err
+		}
+
+		if !s.HasHeader() {
+			n, err := strconv.Atoi(f.Column)
+			if err != nil || n < 1 {
+				return fmt.Errorf(
+					"validating field '%s': column must be a column number when header is false",
+					f.Name)
+			}
+		}
 	}
 
 	for _, f := range s.ComputedFields {
@@ -60,7 +145,7 @@ func (s *Table) validate() error {
 		}
 	}
 
-	err := s.checkDuplicateFieldNames()
+	err = s.checkDuplicateFieldNames()
 	if err != nil {
 		return err
 	}
@@ -68,6 +153,160 @@ func (s *Table) validate() error {
 	return nil
 }
 
+func (s *Table) validateSource() error {
+	if s.CSV != "" && len(s.CSVFiles) != 0 {
+		return errors.New("csv and csvFiles can not be used together")
+	}
+
+	n := 0
+	for _, set := range []bool{
+		s.Source != "",
+		s.CSV != "" || len(s.CSVFiles) != 0,
+		s.XLSX != "",
+		s.JSON != "",
+		s.SQLite != "",
+		s.Parquet != "",
+	} {
+		if set {
+			n++
+		}
+	}
+	if n > 1 {
+		return errors.New("only one of source, csv, csvFiles, xlsx, json, sqlite or parquet can be specified")
+	}
+
+	if s.Format != "" && s.Source == "" {
+		return errors.New("format can only be used with source")
+	}
+
+	if s.Format != "" && !contains(formats, s.Format) {
+		return fmt.Errorf("invalid format '%s'", s.Format)
+	}
+
+	if s.SourceFormat() != FormatCSV {
+		for _, p := range []string{s.Source, s.XLSX, s.JSON, s.SQLite, s.Parquet} {
+			if strings.ContainsAny(p, "*?[") {
+				return fmt.Errorf("%s source must be a single file, glob pattern can only be used with csv format",
+					s.SourceFormat())
+			}
+		}
+	}
+
+	if s.FileNameColumn != "" && s.SourceFormat() != FormatCSV {
+		return errors.New("fileNameColumn can only be used with csv or csvFiles")
+	}
+
+	if !s.HasHeader() && s.SourceFormat() != FormatCSV {
+		return errors.New("header can only be used with csv format")
+	}
+
+	if s.SourceFormat() != FormatCSV {
+		for _, o := range []struct {
+			name string
+			set  bool
+		}{
+			{"skipRows", s.SkipRows != 0},
+			{"skipFooterRows", s.SkipFooterRows != 0},
+			{"comment", s.Comment != ""},
+			{"quote", s.Quote != ""},
+			{"lazyQuotes", s.LazyQuotes},
+			{"trimLeadingSpace", s.TrimLeadingSpace != nil},
+			{"strictFieldCount", s.StrictFieldCount != nil},
+		} {
+			if o.set {
+				return fmt.Errorf("%s can only be used with csv format", o.name)
+			}
+		}
+	}
+
+	for _, f := range s.Fields {
+		if f.Optional && f.Required {
+			return fmt.Errorf("validating field '%s': optional field can not be required",
+				f.Name)
+		}
+
+		if len(f.Aliases) == 0 && !f.Optional {
+			continue
+		}
+		switch s.SourceFormat() {
+		case FormatCSV, FormatXLSX, FormatSQLite:
+			if s.HasHeader() {
+				continue
+			}
+		}
+		return fmt.Errorf(
+			"validating field '%s': aliases and optional can only be used with csv, xlsx or sqlite source having header",
+			f.Name)
+	}
+
+	if s.HeaderMatch != "" {
+		if !contains(headerMatch, s.HeaderMatch) {
+			return fmt.Errorf("invalid headerMatch '%s'", s.HeaderMatch)
+		}
+		if !s.HasHeader() || s.SourceFormat() == FormatFixed {
+			return errors.New("headerMatch can not be used on source without header")
+		}
+	}
+
+	if s.ExtraColumns != "" {
+		if !contains(extraColumns, s.ExtraColumns) {
+			return fmt.Errorf("invalid extraColumns '%s'", s.ExtraColumns)
+		}
+		if !s.HasHeader() || s.SourceFormat() == FormatFixed {
+			return errors.New("extraColumns can not be used on source without header")
+		}
+	}
+
+	if !s.HasHeader() && s.FileNameColumn != "" {
+		return errors.New("fileNameColumn can not be used when header is false")
+	}
+	return nil
+}
+
+func (s *Table) validateCSV() error {
+	if len(s.Separator) != 1 {
+		return errSeparator
+	}
+
+	if s.Comment != "" && len(s.Comment) != 1 {
+		return errComment
+	}
+
+	if s.Quote != "" {
+		if len(s.Quote) != 1 || s.Quote[0] >= utf8.RuneSelf {
+			return errors.New("quote must be a single ASCII character")
+		}
+		if s.Quote == s.Separator || s.Quote == s.Comment || s.Quote == "\n" ||
+			s.Quote == "\r" {
+			return errors.New("quote must be different from separator, comment and line break")
+		}
+	}
+
+	if s.SkipRows < 0 {
+		return errors.New("skipRows must not be negative")
+	}
+
+	if s.SkipFooterRows < 0 {
+		return errors.New("skipFooterRows must not be negative")
+	}
+	return nil
+}
+
+func (s *Table) validateXLSX() error {
+	if s.HeaderRow < 0 {
+		return errors.New("headerRow must be a positive number")
+	}
+
+	if s.HeaderRow != 0 && s.CellRange != "" {
+		return errors.New("headerRow and cellRange can not be used together")
+	}
+
+	if s.CellRange != "" && strings.Count(s.CellRange, ":") != 1 {
+		return fmt.Errorf("invalid cellRange '%s'", s.CellRange)
+	}
+	return nil
+}
+
 func (s *Table) checkDuplicateFieldNames() error {
 	m := make(map[string]bool)
 
@@ -102,18 +341,206 @@ func validateField(f *Field) error {
 		return fmt.Errorf("validating field '%s': type required", f.Name)
 	}
 
-	if !validFieldType(f.Type) {
+	if !validFieldType(f.Type) && !isTypeName(f.Type) {
 		return fmt.Errorf("validating field '%s': invalid type '%s'", f.Name, f.Type)
 	}
 
-	if f.Type == "time" && f.TimeFormat == "" {
+	if _, _, err := ParseNumericType(f.Type); err != nil {
+		return fmt.Errorf("validating field '%s': %w", f.Name, err)
+	}
+
+	elemType, isArray := ArrayElemType(f.Type)
+	if isArray && f.ArrayDelimiter == "" {
+		f.ArrayDelimiter = ","
+	}
+	if !isArray && f.ArrayDelimiter != "" {
+		return fmt.Errorf("validating field '%s': arrayDelimiter can only be used with array type", f.Name)
+	}
+
+	if f.Type == "bytea" && f.BinaryEncoding == "" {
+		f.BinaryEncoding = "hex"
+	}
+	if f.BinaryEncoding != "" {
+		if f.Type != "bytea" {
+			return fmt.Errorf("validating field '%s': binaryEncoding can only be used with bytea type", f.Name)
+		}
+		if !contains(binaryEncodings, f.BinaryEncoding) {
+			return fmt.Errorf("validating field '%s': invalid binaryEncoding '%s'", f.Name, f.BinaryEncoding)
+		}
+	}
+
+	isInterval := strings.HasPrefix(f.Type, "interval")
+	if isInterval && f.DurationFormat == "" {
+		f.DurationFormat = "auto"
+	}
+	if f.DurationFormat != "" {
+		if !isInterval {
+			return fmt.Errorf("validating field '%s': durationFormat can only be used with interval type", f.Name)
+		}
+		if !contains(durationFormats, f.DurationFormat) {
+			return fmt.Errorf("validating field '%s': invalid durationFormat '%s'", f.Name, f.DurationFormat)
+		}
+	}
+
+	err := validateBoolLiterals(f)
+	if err != nil {
+		return err
+	}
+
+	if f.DecodeJSON && f.Type != "json" && f.Type != "jsonb" {
+		return fmt.Errorf("validating field '%s': decodeJSON can only be used with json or jsonb type", f.Name)
+	}
+
+	if (f.Type == "time" || elemType == "time") && f.TimeFormat == "" {
 		return fmt.Errorf("validating field '%s': timeFormat must not be empty", f.Name)
 	}
 
 	return nil
 }
 
+// validateBoolLiterals validates the true and false literals of boolean field.
+func validateBoolLiterals(f *Field) error {
+	if len(f.TrueValues) == 0 && len(f.FalseValues) == 0 {
+		if f.IgnoreCase {
+			return fmt.Errorf("validating field '%s': ignoreCase can only be used with trueValues and falseValues", f.Name)
+		}
+		return nil
+	}
+
+	if f.Type != "boolean" {
+		return fmt.Errorf("validating field '%s': trueValues and falseValues can only be used with boolean type", f.Name)
+	}
+
+	if len(f.TrueValues) == 0 || len(f.FalseValues) == 0 {
+		return fmt.Errorf("validating field '%s': both trueValues and falseValues must be specified", f.Name)
+	}
+
+	m := make(map[string]bool)
+	for _, v := range append(append([]string{}, f.TrueValues...), f.FalseValues...) {
+		if v == "" {
+			return fmt.Errorf("validating field '%s': boolean value cannot be empty", f.Name)
+		}
+		k := v
+		if f.IgnoreCase {
+			k = strings.ToLower(v)
+		}
+		if m[k] {
+			return fmt.Errorf("validating field '%s': duplicate boolean value '%s'", f.Name, v)
+		}
+		m[k] = true
+	}
+	return nil
+}
+
+// ParseNumericType returns the precision and scale of numeric (or decimal)
+// type t, or of the element type if t is an array type. The precision is zero
+// if it is not specified. It returns zero precision and scale if t is not a
+// numeric type.
+func ParseNumericType(t string) (precision, scale int, err error) {
+	if elemType, ok := ArrayElemType(t); ok {
+		t = elemType
+	}
+
+	var args string
+	switch {
+	case hasTypeKeyword(t, "numeric"):
+		args = strings.TrimSpace(strings.TrimPrefix(t, "numeric"))
+	case hasTypeKeyword(t, "decimal"):
+		args = strings.TrimSpace(strings.TrimPrefix(t, "decimal"))
+	default:
+		return 0, 0, nil
+	}
+	if args == "" {
+		return 0, 0, nil
+	}
+
+	errType := fmt.Errorf("invalid numeric type '%s'", t)
+	if !strings.HasPrefix(args, "(") || !strings.HasSuffix(args, ")") {
+		return 0, 0, errType
+	}
+	a := strings.Split(args[1:len(args)-1], ",")
+	if len(a) > 2 {
+		return 0, 0, errType
+	}
+
+	precision, err = strconv.Atoi(strings.TrimSpace(a[0]))
+	if err != nil || precision < 1 || precision > 1000 {
+		return 0, 0, errType
+	}
+	if len(a) == 2 {
+		scale, err = strconv.Atoi(strings.TrimSpace(a[1]))
+		if err != nil || scale < 0 || scale > precision {
+			return 0, 0, errType
+		}
+	}
+	return precision, scale, nil
+}
+
+// validateFieldPosition validates and normalizes the field's position in fixed
+// width format.
+func validateFieldPosition(f *Field, fixed bool) error {
+	if !fixed {
+		if f.Start != 0 || f.Width != 0 || f.End != 0 || f.Trim != "" {
+			return fmt.Errorf(
+				"validating field '%s': start, width, end and trim can only be used with fixed format",
+				f.Name)
+		}
+		return nil
+	}
+
+	if f.Start < 1 {
+		return fmt.Errorf("validating field '%s': start must be a positive number", f.Name)
+	}
+
+	if (f.Width == 0) == (f.End == 0) {
+		return fmt.Errorf("validating field '%s': either width or end must be specified", f.Name)
+	}
+
+	if f.Width < 0 {
+		return fmt.Errorf("validating field '%s': width must be a positive number", f.Name)
+	}
+
+	if f.Width != 0 {
+		f.End = f.Start + f.Width - 1
+	}
+
+	if f.End < f.Start {
+		return fmt.Errorf("validating field '%s': end must not be less than start", f.Name)
+	}
+
+	if f.Trim != "" && !contains(trims, f.Trim) {
+		return fmt.Errorf("validating field '%s': invalid trim '%s'", f.Name, f.Trim)
+	}
+	return nil
+}
+
+// ArrayElemType returns the element type of array type t (e.g. integer for
+// integer[]). ok is false if t is not an array type.
+func ArrayElemType(t string) (elemType string, ok bool) {
+	if !strings.HasSuffix(t, "[]") {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimSuffix(t, "[]")), true
+}
+
+// hasTypeKeyword reports whether type t is the type keyword k, optionally
+// followed by its modifiers (e.g. numeric or numeric(10, 2), but not
+// numeric_grade).
+func hasTypeKeyword(t, k string) bool {
+	return t == k || strings.HasPrefix(t, k+"(") || strings.HasPrefix(t, k+" ")
+}
+
 func validFieldType(ft string) bool {
+	if elemType, ok := ArrayElemType(ft); ok {
+		// nested, json, bytea and interval arrays are not supported
+		if strings.HasSuffix(elemType, "[]") || elemType == "json" ||
+			elemType == "jsonb" || elemType == "bytea" ||
+			strings.HasPrefix(elemType, "interval") {
+			return false
+		}
+		ft = elemType
+	}
+
 	for _, t := range fieldTypes {
 		if t == ft {
 			return true
@@ -141,10 +568,23 @@ func validateComputedField(f *ComputedField) error {
 			"validating computed field '%s': computeFn is required", f.Name)
 	}
 
-	if !validFieldType(f.Type) {
+	if !validFieldType(f.Type) && !isTypeName(f.Type) {
 		return fmt.Errorf("validating computed field '%s': invalid type '%s'",
 			f.Name, f.Type)
 	}
 
+	if _, _, err := ParseNumericType(f.Type); err != nil {
+		return fmt.Errorf("validating computed field '%s': %w", f.Name, err)
+	}
+
 	return nil
 }
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package schema
+
+import "testing"
+
+func TestParseNumericType(t *testing.T) {
+	cases := []struct {
+		t         string
+		precision int
+		scale     int
+	}{
+		{"numeric", 0, 0},
+		{"numeric(10, 2)", 10, 2},
+		{"decimal (5)", 5, 0},
+		{"numeric(12,4)[]", 12, 4},
+		{"numeric_grade", 0, 0},
+		{"decimal_places", 0, 0},
+		{"text", 0, 0},
+	}
+
+	for _, c := range cases {
+		precision, scale, err := ParseNumericType(c.t)
+		if err != nil {
+			t.Errorf("'%s' should not error: %s", c.t, err)
+			continue
+		}
+		if precision != c.precision || scale != c.scale {
+			t.Errorf("different precision and scale for '%s': expected=%d,%d found=%d,%d",
+				c.t, c.precision, c.scale, precision, scale)
+		}
+	}
+
+	_, _, err := ParseNumericType("numeric(0)")
+	if err == nil {
+		t.Error("must returns error")
+	}
+}
+
+func TestValidateFieldEnumNumericPrefix(t *testing.T) {
+	f := &Field{Name: "grade", Type: "numeric_grade"}
+	err := validateField(f)
+	if err != nil {
+		t.Errorf("should not error: %s", err)
+	}
+}
@@ -25,7 +25,7 @@ func init() {
 		// "hasTableDep":    hasTableDep,
 	})
 	addTemplate(tmpl, "runner.go", "/runner.go.tmpl")
-	addTemplate(tmpl, "csvReader.go", "/csvReader.go.tmpl")
+	addTemplate(tmpl, "rowReader.go", "/rowReader.go.tmpl")
 	addTemplate(tmpl, "fieldProvider.go", "/fieldProvider.go.tmpl")
 	addTemplate(tmpl, "converter.go", "/converter.go.tmpl")
 	addTemplate(tmpl, "computer.go", "/computer.go.tmpl")
@@ -33,6 +33,7 @@ func init() {
 	addTemplate(tmpl, "dbSync.go", "/dbSync.go.tmpl")
 	addTemplate(tmpl, "targets.go", "/targets.go.tmpl")
 	addTemplate(tmpl, "view.go", "/view.go.tmpl")
+	addTemplate(tmpl, "type.go", "/type.go.tmpl")
 	addTemplate(tmpl, "main.go", "/main.go.tmpl")
 }
 
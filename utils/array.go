@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// splitArray splits s into array elements separated by delim. It returns nil
+// if s is empty, which is filled as NULL.
+func splitArray(s, delim string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, delim)
+}
+
+// elementError wraps err that occurs when converting the i-th (starting from
+// 0) element of array.
+func elementError(i int, err error) error {
+	return fmt.Errorf("array element %d: %w", i+1, err)
+}
+
+// StringToStringArray converts string of elements separated by delim to
+// []string.
+func StringToStringArray(s, delim string) ([]string, error) {
+	return splitArray(s, delim), nil
+}
+
+// StringToBoolArray converts string of elements separated by delim to []bool.
+func StringToBoolArray(s, delim string) ([]bool, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]bool, len(elems))
+	for i, e := range elems {
+		v, err := strconv.ParseBool(strings.TrimSpace(e))
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
+
+// StringToFloat64Array converts string of elements separated by delim to
+// []float64.
+func StringToFloat64Array(s, delim string) ([]float64, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]float64, len(elems))
+	for i, e := range elems {
+		v, err := strconv.ParseFloat(strings.TrimSpace(e), 64)
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
+
+// StringToInt32Array converts string of elements separated by delim to
+// []int32.
+func StringToInt32Array(s, delim string) ([]int32, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]int32, len(elems))
+	for i, e := range elems {
+		v, err := strconv.ParseInt(strings.TrimSpace(e), 10, 32)
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = int32(v)
+	}
+	return a, nil
+}
+
+// StringToInt64Array converts string of elements separated by delim to
+// []int64.
+func StringToInt64Array(s, delim string) ([]int64, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]int64, len(elems))
+	for i, e := range elems {
+		v, err := strconv.ParseInt(strings.TrimSpace(e), 10, 64)
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
+
+// StringToTimeArray converts string of elements separated by delim to
+// []time.Time.
+func StringToTimeArray(layout, s, delim string) ([]time.Time, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]time.Time, len(elems))
+	for i, e := range elems {
+		v, err := time.Parse(layout, strings.TrimSpace(e))
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
+
+// StringToNumericArray converts string of elements separated by delim to
+// array of numeric values in []string. See StringToNumeric.
+func StringToNumericArray(s, delim string, precision, scale int) ([]string, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]string, len(elems))
+	for i, e := range elems {
+		v, err := StringToNumeric(e, precision, scale)
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
+
+// StringToMoneyArray converts string of elements separated by delim to array
+// of money values in []string. See StringToMoney.
+func StringToMoneyArray(s, delim string) ([]string, error) {
+	elems := splitArray(s, delim)
+	if elems == nil {
+		return nil, nil
+	}
+
+	a := make([]string, len(elems))
+	for i, e := range elems {
+		v, err := StringToMoney(e)
+		if err != nil {
+			return nil, elementError(i, err)
+		}
+		a[i] = v
+	}
+	return a, nil
+}
@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStringToArray(t *testing.T) {
+	s, err := StringToStringArray("tag1|tag2||tag3", "|")
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	expectedS := []string{"tag1", "tag2", "", "tag3"}
+	if !reflect.DeepEqual(s, expectedS) {
+		t.Errorf("different value: expected=%v found=%v", expectedS, s)
+	}
+
+	n, err := StringToInt32Array("1| 2 |3", "|")
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	expectedN := []int32{1, 2, 3}
+	if !reflect.DeepEqual(n, expectedN) {
+		t.Errorf("different value: expected=%v found=%v", expectedN, n)
+	}
+
+	m, err := StringToNumericArray("1.5;2", ";", 5, 2)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	expectedM := []string{"1.50", "2.00"}
+	if !reflect.DeepEqual(m, expectedM) {
+		t.Errorf("different value: expected=%v found=%v", expectedM, m)
+	}
+
+	n, err = StringToInt32Array("", "|")
+	if err != nil || n != nil {
+		t.Errorf("empty value must be NULL, found=%v err=%v", n, err)
+	}
+
+	_, err = StringToInt64Array("1|x|3", "|")
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	if !strings.HasPrefix(err.Error(), "array element 2:") {
+		t.Errorf("different error: found=%s", err)
+	}
+}
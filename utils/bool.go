@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// BoolLiterals specifies the literals of boolean values in data source.
+type BoolLiterals struct {
+	True       []string
+	False      []string
+	IgnoreCase bool
+}
+
+// match returns true if s is one of literals.
+func (l BoolLiterals) match(s string, literals []string) bool {
+	for _, v := range literals {
+		if v == s || l.IgnoreCase && strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// StringToCustomBool converts s to bool using boolean literals l.
+func StringToCustomBool(s string, l BoolLiterals) (bool, error) {
+	switch {
+	case l.match(s, l.True):
+		return true, nil
+	case l.match(s, l.False):
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean value '%s': must be one of '%s'",
+		s, strings.Join(append(append([]string{}, l.True...), l.False...), "', '"))
+}
+
+// StringToNullCustomBool converts s to sql.NullBool using boolean literals l.
+func StringToNullCustomBool(s string, l BoolLiterals) (sql.NullBool, error) {
+	if s == "" {
+		return sql.NullBool{}, nil
+	}
+
+	v, err := StringToCustomBool(s, l)
+	if err != nil {
+		return sql.NullBool{}, err
+	}
+
+	return sql.NullBool{
+		Bool:  v,
+		Valid: true,
+	}, nil
+}
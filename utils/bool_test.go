@@ -0,0 +1,42 @@
+package utils
+
+import "testing"
+
+func TestStringToCustomBool(t *testing.T) {
+	l := BoolLiterals{
+		True:  []string{"Y", "Ya", "Active"},
+		False: []string{"N", "Tidak", "Inactive"},
+	}
+
+	cases := map[string]bool{"Y": true, "Ya": true, "Active": true, "N": false, "Tidak": false}
+	for s, expected := range cases {
+		found, err := StringToCustomBool(s, l)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", s, err)
+			continue
+		}
+		if found != expected {
+			t.Errorf("different value for '%s': expected=%t found=%t", s, expected, found)
+		}
+	}
+
+	_, err := StringToCustomBool("active", l)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	expectedErr := "invalid boolean value 'active': must be one of 'Y', 'Ya', 'Active', 'N', 'Tidak', 'Inactive'"
+	if err.Error() != expectedErr {
+		t.Errorf("different error: expected=%s found=%s", expectedErr, err)
+	}
+
+	l.IgnoreCase = true
+	found, err := StringToCustomBool("inACTIVE", l)
+	if err != nil || found {
+		t.Errorf("'inACTIVE' must be false, found=%t err=%v", found, err)
+	}
+
+	v, err := StringToNullCustomBool("", l)
+	if err != nil || v.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", v, err)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Binary encodings of bytea value in data source.
+const (
+	BinaryEncodingHex    = "hex"
+	BinaryEncodingBase64 = "base64"
+	BinaryEncodingRaw    = "raw"
+)
+
+// NullBytes is a bytea value that may be NULL. It implements driver.Valuer.
+type NullBytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// Value implements the driver.Valuer interface.
+func (b NullBytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// StringToBytes decodes s using binary encoding (hex, base64 or raw). A hex
+// value may have a leading \x as in PostgreSQL's bytea output. A base64 value
+// may omit its padding.
+func StringToBytes(s, encoding string) ([]byte, error) {
+	var v []byte
+	var err error
+	switch encoding {
+	case BinaryEncodingHex:
+		v, err = hex.DecodeString(strings.TrimPrefix(s, `\x`))
+	case BinaryEncodingBase64:
+		if len(s)%4 == 0 {
+			v, err = base64.StdEncoding.DecodeString(s)
+		} else {
+			v, err = base64.RawStdEncoding.DecodeString(s)
+		}
+	case BinaryEncodingRaw:
+		return []byte(s), nil
+	default:
+		return nil, fmt.Errorf("invalid binary encoding '%s'", encoding)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s value: %w", encoding, err)
+	}
+	return v, nil
+}
+
+// StringToNullBytes decodes s using binary encoding to NullBytes. See
+// StringToBytes.
+func StringToNullBytes(s, encoding string) (NullBytes, error) {
+	if s == "" {
+		return NullBytes{}, nil
+	}
+
+	v, err := StringToBytes(s, encoding)
+	if err != nil {
+		return NullBytes{}, err
+	}
+
+	return NullBytes{
+		Bytes: v,
+		Valid: true,
+	}, nil
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestStringToBytes(t *testing.T) {
+	cases := []struct {
+		s        string
+		encoding string
+		expected []byte
+	}{
+		{`\x00ff10`, BinaryEncodingHex, []byte{0, 255, 16}},
+		{"00FF10", BinaryEncodingHex, []byte{0, 255, 16}},
+		{"AP8Q", BinaryEncodingBase64, []byte{0, 255, 16}},
+		{"AP8=", BinaryEncodingBase64, []byte{0, 255}},
+		{"AP8", BinaryEncodingBase64, []byte{0, 255}},
+		{"a\\b", BinaryEncodingRaw, []byte("a\\b")},
+	}
+
+	for _, c := range cases {
+		found, err := StringToBytes(c.s, c.encoding)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", c.s, err)
+			continue
+		}
+		if !bytes.Equal(found, c.expected) {
+			t.Errorf("different value for '%s': expected=%v found=%v",
+				c.s, c.expected, found)
+		}
+	}
+
+	_, err := StringToBytes("AP8Q!", BinaryEncodingBase64)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid base64 value: illegal base64 data at input byte 4") {
+		t.Errorf("different error: found=%s", err)
+	}
+
+	_, err = StringToBytes("0g", BinaryEncodingHex)
+	if err == nil {
+		t.Error("must returns error")
+	}
+
+	v, err := StringToNullBytes("", BinaryEncodingHex)
+	if err != nil || v.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", v, err)
+	}
+}
@@ -2,36 +2,252 @@ package utils
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
+	"unicode"
+
+	"github.com/iancoleman/strcase"
+)
+
+// Header matching policies used by CreateHeaderMatch.
+const (
+	// HeaderMatchExact matches header names byte-for-byte.
+	HeaderMatchExact = "exact"
+
+	// HeaderMatchTrimmed ignores white spaces around header names.
+	HeaderMatchTrimmed = "trimmed"
+
+	// HeaderMatchCaseInsensitive ignores the case of header names.
+	HeaderMatchCaseInsensitive = "case-insensitive"
+
+	// HeaderMatchNormalized compares header names in snake case, ignoring
+	// case, spaces and punctuation (e.g. " Customer ID", "customer_id" and
+	// "CustomerId" are the same).
+	HeaderMatchNormalized = "normalized"
+)
+
+// Policies on header columns not used by any field, used by ResolveHeader.
+const (
+	ExtraColumnsIgnore = "ignore"
+	ExtraColumnsWarn   = "warn"
+	ExtraColumnsError  = "error"
 )
 
 // CreateHeader creates header from columns.
 // Using fieldColumnMap to filter only requested field and also rename it.
+// Header names are matched byte-for-byte.
 func CreateHeader(columns []string, fieldColumnMap map[string]string) (map[string]int, error) {
-	m := make(map[string]int, len(fieldColumnMap))
+	return CreateHeaderMatch(columns, fieldColumnMap, HeaderMatchExact)
+}
+
+// CreateHeaderMatch creates header from columns like CreateHeader, but header
+// names are matched using policy match (one of HeaderMatch constants). It
+// returns error if a column matches more than one header.
+func CreateHeaderMatch(columns []string, fieldColumnMap map[string]string, match string) (map[string]int, error) {
+	spec := HeaderSpec{
+		Columns: make(map[string][]string, len(fieldColumnMap)),
+		Match:   match,
+	}
 	for n, c := range fieldColumnMap {
-		for i, h := range columns {
-			if c == h {
-				m[n] = i
+		spec.Columns[n] = []string{c}
+	}
+
+	m, _, err := ResolveHeader(columns, spec)
+	return m, err
+}
+
+// HeaderSpec specifies how fields are mapped to the columns of header row.
+type HeaderSpec struct {
+	// Columns maps field name to its candidate column names. The first
+	// candidate found in header is used.
+	Columns map[string][]string
+
+	// Match is the header matching policy, one of HeaderMatch constants.
+	Match string
+
+	// Optional specifies fields whose column may be absent from header. An
+	// absent optional field is mapped to index -1, so it is provided as empty
+	// value.
+	Optional map[string]bool
+
+	// ExtraColumns is the policy on header columns not used by any field, one
+	// of ExtraColumns constants. Default to ExtraColumnsIgnore. If not
+	// ignored, the drift report lists the new (unused) and vanished (absent
+	// optional) columns.
+	ExtraColumns string
+
+	// FileNameColumn is the name of column added by the reader containing
+	// the source file name. It is never reported as a new column.
+	FileNameColumn string
+}
+
+// ResolveHeader maps each field in spec to the index of its column in header.
+// It also returns warnings about the mapping, such as a field mapped using
+// a candidate other than the first one.
+func ResolveHeader(header []string, spec HeaderSpec) (map[string]int, []string, error) {
+	key, err := headerKeyFunc(spec.Match)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch spec.ExtraColumns {
+	case "", ExtraColumnsIgnore, ExtraColumnsWarn, ExtraColumnsError:
+	default:
+		return nil, nil, fmt.Errorf("invalid extra columns policy '%s'", spec.ExtraColumns)
+	}
+
+	keys := make([]string, len(header))
+	for i, h := range header {
+		keys[i] = key(h)
+	}
+
+	names := make([]string, 0, len(spec.Columns))
+	for n := range spec.Columns {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	m := make(map[string]int, len(spec.Columns))
+	var warnings []string
+	for _, n := range names {
+		candidates := spec.Columns[n]
+		for ci, c := range candidates {
+			var found []int
+			k := key(c)
+			for i := range header {
+				if keys[i] == k {
+					found = append(found, i)
+				}
 			}
+
+			if len(found) > 1 {
+				a := make([]string, len(found))
+				for i, idx := range found {
+					a[i] = fmt.Sprintf("'%s' (column %d)", header[idx], idx+1)
+				}
+				return nil, nil, fmt.Errorf("column '%s' for field '%s' matches multiple headers: %s",
+					c, n, strings.Join(a, ", "))
+			}
+			if len(found) == 1 {
+				m[n] = found[0]
+				if ci > 0 {
+					warnings = append(warnings, fmt.Sprintf(
+						"field '%s' uses alias column '%s'", n, header[found[0]]))
+				}
+				break
+			}
+		}
+
+		if _, ok := m[n]; !ok {
+			if !spec.Optional[n] {
+				return nil, nil, fmt.Errorf("column %s for field '%s' not found",
+					quoteColumns(candidates), n)
+			}
+			m[n] = -1
+			warnings = append(warnings, fmt.Sprintf(
+				"column %s for field '%s' not found, loading NULL",
+				quoteColumns(candidates), n))
 		}
+	}
 
-		_, ok := m[n]
-		if !ok {
-			return nil, fmt.Errorf("column '%s' for field '%s' not found", c, n)
+	drift := headerDrift(header, spec, m)
+	if drift != "" {
+		switch spec.ExtraColumns {
+		case ExtraColumnsWarn:
+			warnings = append(warnings, drift)
+		case ExtraColumnsError:
+			return nil, nil, errors.New(drift)
 		}
 	}
 
-	return m, nil
+	return m, warnings, nil
+}
+
+// headerDrift returns the report of header columns not used by any field in
+// m and the absent optional columns. It returns empty string if there are
+// neither.
+func headerDrift(header []string, spec HeaderSpec, m map[string]int) string {
+	used := make(map[int]bool, len(m))
+	var vanished []string
+	for n, i := range m {
+		if i < 0 {
+			vanished = append(vanished, "'"+spec.Columns[n][0]+"'")
+		}
+		used[i] = true
+	}
+
+	var added []string
+	for i, h := range header {
+		if !used[i] && (spec.FileNameColumn == "" || h != spec.FileNameColumn) {
+			added = append(added, fmt.Sprintf("'%s' (column %d)", h, i+1))
+		}
+	}
+
+	var a []string
+	if len(added) != 0 {
+		a = append(a, "new columns "+strings.Join(added, ", "))
+	}
+	if len(vanished) != 0 {
+		sort.Strings(vanished)
+		a = append(a, "vanished columns "+strings.Join(vanished, ", "))
+	}
+	if len(a) == 0 {
+		return ""
+	}
+	return "schema drift: " + strings.Join(a, "; ")
+}
+
+// quoteColumns returns quoted column name, along with its aliases if any.
+func quoteColumns(a []string) string {
+	if len(a) == 0 {
+		return "''"
+	}
+	s := "'" + a[0] + "'"
+	if len(a) > 1 {
+		s += " (or aliases '" + strings.Join(a[1:], "', '") + "')"
+	}
+	return s
+}
+
+// headerKeyFunc returns the function that returns the key of header name
+// compared using policy match.
+func headerKeyFunc(match string) (func(string) string, error) {
+	switch match {
+	case "", HeaderMatchExact:
+		return func(s string) string { return s }, nil
+	case HeaderMatchTrimmed:
+		return strings.TrimSpace, nil
+	case HeaderMatchCaseInsensitive:
+		return strings.ToLower, nil
+	case HeaderMatchNormalized:
+		return normalizeHeader, nil
+	}
+	return nil, fmt.Errorf("invalid header match '%s'", match)
+}
+
+// normalizeHeader returns header name s in snake case. Characters other than
+// letters and digits are treated as word separators.
+func normalizeHeader(s string) string {
+	s = strings.Join(strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+	return strcase.ToSnake(s)
 }
 
 // ProvideField provides row to be accessed by field names using map.
-// The returned map will only provide the specified fields.
+// The returned map will only provide the specified fields. Fields with
+// negative index (absent optional columns) are provided as empty string.
 func ProvideField(fieldColumnMap map[string]int, row []string) (map[string]interface{}, error) {
 	m := make(map[string]interface{}, len(fieldColumnMap))
 	for k, v := range fieldColumnMap {
+		if v < 0 {
+			m[k] = ""
+			continue
+		}
 		if v >= len(row) {
 			return nil, fmt.Errorf("invalid index for field '%s'", k)
 		}
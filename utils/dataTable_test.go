@@ -82,3 +82,211 @@ func TestCreateHeader(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateHeaderMatch(t *testing.T) {
+	columns := []string{" Customer ID", "Full Name ", "AMOUNT (USD)"}
+
+	cases := []struct {
+		match          string
+		fieldColumnMap map[string]string
+		expected       map[string]int
+	}{
+		{HeaderMatchExact, map[string]string{"id": " Customer ID"}, map[string]int{"id": 0}},
+		{HeaderMatchTrimmed, map[string]string{"id": "Customer ID", "name": "Full Name"},
+			map[string]int{"id": 0, "name": 1}},
+		{HeaderMatchCaseInsensitive, map[string]string{"amount": "Amount (USD)"},
+			map[string]int{"amount": 2}},
+		{HeaderMatchNormalized, map[string]string{"id": "customer_id", "name": "FullName", "amount": "amount_usd"},
+			map[string]int{"id": 0, "name": 1, "amount": 2}},
+	}
+
+	for _, c := range cases {
+		found, err := CreateHeaderMatch(columns, c.fieldColumnMap, c.match)
+		if err != nil {
+			t.Errorf("%s: must not returns error: %s", c.match, err)
+			continue
+		}
+		for k, v := range c.expected {
+			if found[k] != v {
+				t.Errorf("%s: different value for key '%s': expected=%d found=%d",
+					c.match, k, v, found[k])
+			}
+		}
+	}
+
+	t.Run("error when column not found", func(t *testing.T) {
+		_, err := CreateHeaderMatch(columns, map[string]string{"id": "customer id"},
+			HeaderMatchTrimmed)
+		if err == nil {
+			t.Error("must returns error")
+		}
+	})
+
+	t.Run("error on duplicate headers", func(t *testing.T) {
+		columns := []string{"a", "b", "a"}
+		expected := "column 'a' for field 'x' matches multiple headers: " +
+			"'a' (column 1), 'a' (column 3)"
+
+		_, err := CreateHeader(columns, map[string]string{"x": "a"})
+		if err == nil {
+			t.Fatal("must returns error")
+		}
+		if expected != err.Error() {
+			t.Errorf(`different error: expected="%s" found="%s"`,
+				expected, err.Error())
+		}
+	})
+
+	t.Run("error on ambiguous headers", func(t *testing.T) {
+		columns := []string{"Customer ID", "customer_id"}
+		expected := "column 'customer_id' for field 'id' matches multiple headers: " +
+			"'Customer ID' (column 1), 'customer_id' (column 2)"
+
+		_, err := CreateHeaderMatch(columns, map[string]string{"id": "customer_id"},
+			HeaderMatchNormalized)
+		if err == nil {
+			t.Fatal("must returns error")
+		}
+		if expected != err.Error() {
+			t.Errorf(`different error: expected="%s" found="%s"`,
+				expected, err.Error())
+		}
+	})
+
+	t.Run("error on invalid policy", func(t *testing.T) {
+		_, err := CreateHeaderMatch(columns, map[string]string{}, "fuzzy")
+		if err == nil {
+			t.Error("must returns error")
+		}
+	})
+}
+
+func TestResolveHeaderAliases(t *testing.T) {
+	header := []string{"Kode", "Nama", "Kota"}
+	spec := HeaderSpec{
+		Columns: map[string][]string{
+			"code": {"Kode"},
+			"name": {"Name", "Nama", "Customer Name"},
+		},
+	}
+
+	found, warnings, err := ResolveHeader(header, spec)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if found["code"] != 0 || found["name"] != 1 {
+		t.Errorf("different result: expected=map[code:0 name:1] found=%v", found)
+	}
+	expected := "field 'name' uses alias column 'Nama'"
+	if len(warnings) != 1 || warnings[0] != expected {
+		t.Errorf("different warnings: expected=[%s] found=%v", expected, warnings)
+	}
+
+	spec.Columns["city"] = []string{"City", "Town"}
+	_, _, err = ResolveHeader(header, spec)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	expected = "column 'City' (or aliases 'Town') for field 'city' not found"
+	if expected != err.Error() {
+		t.Errorf(`different error: expected="%s" found="%s"`, expected, err.Error())
+	}
+}
+
+func TestResolveHeaderOptional(t *testing.T) {
+	header := []string{"id"}
+	spec := HeaderSpec{
+		Columns:  map[string][]string{"id": {"id"}, "note": {"note", "remark"}},
+		Optional: map[string]bool{"note": true},
+	}
+
+	found, warnings, err := ResolveHeader(header, spec)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if found["note"] != -1 {
+		t.Errorf("different index for 'note': expected=-1 found=%d", found["note"])
+	}
+	expected := "column 'note' (or aliases 'remark') for field 'note' not found, loading NULL"
+	if len(warnings) != 1 || warnings[0] != expected {
+		t.Errorf("different warnings: expected=[%s] found=%v", expected, warnings)
+	}
+
+	m, err := ProvideField(found, []string{"7"})
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if m["id"] != "7" || m["note"] != "" {
+		t.Errorf("different fields: expected=map[id:7 note:] found=%v", m)
+	}
+}
+
+func TestResolveHeaderExtraColumns(t *testing.T) {
+	header := []string{"id", "region", "name", "channel"}
+	spec := HeaderSpec{
+		Columns:  map[string][]string{"id": {"id"}, "name": {"name"}, "note": {"note"}},
+		Optional: map[string]bool{"note": true},
+	}
+	expected := "schema drift: new columns 'region' (column 2), 'channel' (column 4); " +
+		"vanished columns 'note'"
+
+	_, warnings, err := ResolveHeader(header, spec)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("different number of warnings: expected=1 found=%d", len(warnings))
+	}
+
+	spec.ExtraColumns = ExtraColumnsWarn
+	_, warnings, err = ResolveHeader(header, spec)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if len(warnings) != 2 || warnings[1] != expected {
+		t.Errorf("different warnings: expected=[... %s] found=%v", expected, warnings)
+	}
+
+	spec.ExtraColumns = ExtraColumnsError
+	_, _, err = ResolveHeader(header, spec)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	if expected != err.Error() {
+		t.Errorf(`different error: expected="%s" found="%s"`, expected, err.Error())
+	}
+
+	_, _, err = ResolveHeader(header[:1], HeaderSpec{
+		Columns:      map[string][]string{"id": {"id"}},
+		ExtraColumns: ExtraColumnsError,
+	})
+	if err != nil {
+		t.Errorf("must not returns error: %s", err)
+	}
+}
+
+func TestResolveHeaderVanishedColumns(t *testing.T) {
+	header := []string{"id", "file"}
+	spec := HeaderSpec{
+		Columns:        map[string][]string{"id": {"id"}, "note": {"note"}},
+		Optional:       map[string]bool{"note": true},
+		ExtraColumns:   ExtraColumnsError,
+		FileNameColumn: "file",
+	}
+	expected := "schema drift: vanished columns 'note'"
+
+	_, _, err := ResolveHeader(header, spec)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	if expected != err.Error() {
+		t.Errorf(`different error: expected="%s" found="%s"`, expected, err.Error())
+	}
+
+	spec.Optional = nil
+	spec.Columns = map[string][]string{"id": {"id"}}
+	_, _, err = ResolveHeader(header, spec)
+	if err != nil {
+		t.Errorf("must not returns error: %s", err)
+	}
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"fmt"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// LookupEncoding returns the character encoding by its name (e.g. utf-8,
+// windows-1252, iso-8859-1 or utf-16le). Both WHATWG and IANA names are
+// recognized.
+func LookupEncoding(name string) (encoding.Encoding, error) {
+	e, err := htmlindex.Get(name)
+	if err == nil {
+		return e, nil
+	}
+
+	e, err = ianaindex.IANA.Encoding(name)
+	if err != nil || e == nil {
+		return nil, fmt.Errorf("unsupported encoding '%s'", name)
+	}
+	return e, nil
+}
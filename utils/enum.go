@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// StringToEnum validates that s is one of enum values.
+func StringToEnum(s string, values []string) (string, error) {
+	for _, v := range values {
+		if v == s {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("invalid value '%s': must be one of '%s'",
+		s, strings.Join(values, "', '"))
+}
+
+// StringToNullEnum converts string to enum value in sql.NullString. See
+// StringToEnum.
+func StringToNullEnum(s string, values []string) (sql.NullString, error) {
+	if s == "" {
+		return sql.NullString{}, nil
+	}
+
+	v, err := StringToEnum(s, values)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{
+		String: v,
+		Valid:  true,
+	}, nil
+}
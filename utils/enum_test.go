@@ -0,0 +1,29 @@
+package utils
+
+import "testing"
+
+func TestStringToEnum(t *testing.T) {
+	values := []string{"low", "medium", "high"}
+
+	v, err := StringToEnum("medium", values)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if v != "medium" {
+		t.Errorf("different value: expected=medium found=%s", v)
+	}
+
+	_, err = StringToEnum("Medium", values)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	expected := "invalid value 'Medium': must be one of 'low', 'medium', 'high'"
+	if err.Error() != expected {
+		t.Errorf("different error: expected=%s found=%s", expected, err)
+	}
+
+	n, err := StringToNullEnum("", values)
+	if err != nil || n.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", n, err)
+	}
+}
@@ -0,0 +1,235 @@
+package utils
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration formats of interval value in data source.
+const (
+	// DurationFormatAuto detects the format of each value: ISO 8601 if it
+	// starts with P, clock if it contains ':', otherwise Go duration.
+	DurationFormatAuto = "auto"
+
+	// DurationFormatGo is Go duration format (e.g. 1h30m or 1.5s).
+	DurationFormatGo = "go"
+
+	// DurationFormatISO8601 is ISO 8601 duration format (e.g. P1DT2H or PT45M).
+	DurationFormatISO8601 = "iso8601"
+
+	// DurationFormatClock is hh:mm[:ss[.ffffff]] format (e.g. 02:15:00).
+	DurationFormatClock = "clock"
+)
+
+var (
+	iso8601Pattern = regexp.MustCompile(
+		`^([+-])?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?` +
+			`(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:[.,](\d{1,6}))?S)?)?$`)
+	clockPattern = regexp.MustCompile(
+		`^([+-])?(\d+):(\d{2})(?::(\d{2})(?:\.(\d{1,6}))?)?$`)
+)
+
+// Interval is an interval value. Like in PostgreSQL, months and days are kept
+// separately from the time part because their length varies. It implements
+// driver.Valuer.
+type Interval struct {
+	Months       int32
+	Days         int32
+	Microseconds int64
+}
+
+// String returns the interval in PostgreSQL format (e.g. 1 mons 2 days
+// 03:04:05.000006).
+func (v Interval) String() string {
+	sign := ""
+	us := v.Microseconds
+	if us < 0 {
+		sign = "-"
+		us = -us
+	}
+	us, frac := us/1e6, us%1e6
+	clock := fmt.Sprintf("%s%02d:%02d:%02d", sign, us/3600, us/60%60, us%60)
+	if frac != 0 {
+		clock += fmt.Sprintf(".%06d", frac)
+	}
+	return fmt.Sprintf("%d mons %d days %s", v.Months, v.Days, clock)
+}
+
+// Value implements the driver.Valuer interface.
+func (v Interval) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// NullInterval is an interval value that may be NULL. It implements
+// driver.Valuer.
+type NullInterval struct {
+	Interval Interval
+	Valid    bool // Valid is true if Interval is not NULL
+}
+
+// Value implements the driver.Valuer interface.
+func (v NullInterval) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	return v.Interval.Value()
+}
+
+// StringToInterval converts s in duration format (auto, go, iso8601 or clock)
+// to Interval.
+func StringToInterval(s, format string) (Interval, error) {
+	if format == DurationFormatAuto {
+		format = detectDurationFormat(s)
+	}
+
+	var v Interval
+	var err error
+	switch format {
+	case DurationFormatGo:
+		v, err = parseGoDuration(s)
+	case DurationFormatISO8601:
+		v, err = parseISO8601Duration(s)
+	case DurationFormatClock:
+		v, err = parseClockDuration(s)
+	default:
+		return Interval{}, fmt.Errorf("invalid duration format '%s'", format)
+	}
+
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid %s duration '%s': %w", format, s, err)
+	}
+	return v, nil
+}
+
+// StringToNullInterval converts s in duration format to NullInterval. See
+// StringToInterval.
+func StringToNullInterval(s, format string) (NullInterval, error) {
+	if s == "" {
+		return NullInterval{}, nil
+	}
+
+	v, err := StringToInterval(s, format)
+	if err != nil {
+		return NullInterval{}, err
+	}
+
+	return NullInterval{
+		Interval: v,
+		Valid:    true,
+	}, nil
+}
+
+func detectDurationFormat(s string) string {
+	v := strings.TrimLeft(s, "+-")
+	switch {
+	case strings.HasPrefix(v, "P"):
+		return DurationFormatISO8601
+	case strings.Contains(v, ":"):
+		return DurationFormatClock
+	}
+	return DurationFormatGo
+}
+
+func parseGoDuration(s string) (Interval, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return Interval{}, errors.New("not a Go duration")
+	}
+	if d%time.Microsecond != 0 {
+		return Interval{}, errors.New("precision is finer than microsecond")
+	}
+	return Interval{Microseconds: int64(d / time.Microsecond)}, nil
+}
+
+func parseISO8601Duration(s string) (Interval, error) {
+	m := iso8601Pattern.FindStringSubmatch(s)
+	if m == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return Interval{}, errors.New("not an ISO 8601 duration")
+	}
+
+	n := make([]int64, len(m))
+	for i := 2; i < len(m); i++ {
+		if m[i] == "" {
+			continue
+		}
+		v, err := strconv.ParseInt(m[i], 10, 64)
+		if err != nil {
+			return Interval{}, errors.New("value out of range")
+		}
+		n[i] = v
+	}
+	v, err := newInterval(n[2], n[3], n[4], n[5], n[6], n[7], n[8],
+		fractionMicroseconds(m[9]))
+	if err != nil {
+		return Interval{}, err
+	}
+	if m[1] == "-" {
+		v = Interval{Months: -v.Months, Days: -v.Days, Microseconds: -v.Microseconds}
+	}
+	return v, nil
+}
+
+func parseClockDuration(s string) (Interval, error) {
+	m := clockPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Interval{}, errors.New("not a clock duration")
+	}
+
+	hours, err := strconv.ParseInt(m[2], 10, 64)
+	if err != nil {
+		return Interval{}, errors.New("value out of range")
+	}
+	minutes, _ := strconv.ParseInt(m[3], 10, 64)
+	var seconds int64
+	if m[4] != "" {
+		seconds, _ = strconv.ParseInt(m[4], 10, 64)
+	}
+	if minutes > 59 || seconds > 59 {
+		return Interval{}, errors.New("minutes and seconds must be less than 60")
+	}
+
+	v, err := newInterval(0, 0, 0, 0, hours, minutes, seconds,
+		fractionMicroseconds(m[5]))
+	if err != nil {
+		return Interval{}, err
+	}
+	if m[1] == "-" {
+		v.Microseconds = -v.Microseconds
+	}
+	return v, nil
+}
+
+// newInterval creates Interval from its parts after checking that it does not
+// overflow.
+func newInterval(years, months, weeks, days, hours, minutes, seconds, us int64) (Interval, error) {
+	errRange := errors.New("value out of range")
+	maxSeconds := int64(math.MaxInt64/1000000) - 1
+	if years > math.MaxInt32/12 || months > math.MaxInt32 ||
+		weeks > math.MaxInt32/7 || days > math.MaxInt32 ||
+		hours > maxSeconds/3600 || minutes > maxSeconds/60 || seconds > maxSeconds {
+		return Interval{}, errRange
+	}
+
+	m := years*12 + months
+	d := weeks*7 + days
+	sec := hours*3600 + minutes*60 + seconds
+	if m > math.MaxInt32 || d > math.MaxInt32 || sec > maxSeconds {
+		return Interval{}, errRange
+	}
+	return Interval{Months: int32(m), Days: int32(d), Microseconds: sec*1e6 + us}, nil
+}
+
+// fractionMicroseconds returns the microseconds of fractional second digits.
+func fractionMicroseconds(digits string) int64 {
+	if digits == "" {
+		return 0
+	}
+	v, _ := strconv.ParseInt((digits + "000000")[:6], 10, 64)
+	return v
+}
@@ -0,0 +1,58 @@
+package utils
+
+import "testing"
+
+func TestStringToInterval(t *testing.T) {
+	cases := []struct {
+		s        string
+		format   string
+		expected string
+	}{
+		{"1h30m", DurationFormatAuto, "0 mons 0 days 01:30:00"},
+		{"-1.5s", DurationFormatGo, "0 mons 0 days -00:00:01.500000"},
+		{"PT45M", DurationFormatAuto, "0 mons 0 days 00:45:00"},
+		{"P1Y2M1W3DT4H5M6.5S", DurationFormatISO8601, "14 mons 10 days 04:05:06.500000"},
+		{"-P1D", DurationFormatISO8601, "0 mons -1 days 00:00:00"},
+		{"02:15:00", DurationFormatAuto, "0 mons 0 days 02:15:00"},
+		{"100:05", DurationFormatClock, "0 mons 0 days 100:05:00"},
+		{"-00:00:01.25", DurationFormatClock, "0 mons 0 days -00:00:01.250000"},
+	}
+
+	for _, c := range cases {
+		found, err := StringToInterval(c.s, c.format)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", c.s, err)
+			continue
+		}
+		if found.String() != c.expected {
+			t.Errorf("different value for '%s': expected=%s found=%s",
+				c.s, c.expected, found)
+		}
+	}
+
+	errCases := []struct {
+		s      string
+		format string
+	}{
+		{"P", DurationFormatISO8601},
+		{"P1DT", DurationFormatISO8601},
+		{"PT1.5M", DurationFormatISO8601},
+		{"1h", DurationFormatISO8601},
+		{"01:60", DurationFormatClock},
+		{"1ns", DurationFormatGo},
+		{"abc", DurationFormatAuto},
+		{"P99999999999Y", DurationFormatAuto},
+	}
+
+	for _, c := range errCases {
+		_, err := StringToInterval(c.s, c.format)
+		if err == nil {
+			t.Errorf("'%s' in %s format must returns error", c.s, c.format)
+		}
+	}
+
+	v, err := StringToNullInterval("", DurationFormatAuto)
+	if err != nil || v.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", v, err)
+	}
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// JSON is a decoded JSON value that may be NULL. It implements driver.Valuer,
+// so it is encoded back to JSON text when filled into database.
+type JSON struct {
+	Data  interface{}
+	Valid bool // Valid is true if Data is not NULL
+}
+
+// Value implements the driver.Valuer interface.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	b, err := json.Marshal(j.Data)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// checkJSON returns error describing the position of syntax error in s.
+func checkJSON(s string) error {
+	if json.Valid([]byte(s)) {
+		return nil
+	}
+
+	var v interface{}
+	err := json.Unmarshal([]byte(s), &v)
+	var se *json.SyntaxError
+	if errors.As(err, &se) {
+		return fmt.Errorf("invalid JSON at offset %d: %s", se.Offset, se.Error())
+	}
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	return errors.New("invalid JSON")
+}
+
+// StringToJSON validates the syntax of JSON text s.
+func StringToJSON(s string) (string, error) {
+	err := checkJSON(s)
+	if err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// StringToNullJSON converts JSON text to sql.NullString after validating its
+// syntax.
+func StringToNullJSON(s string) (sql.NullString, error) {
+	if s == "" {
+		return sql.NullString{}, nil
+	}
+
+	v, err := StringToJSON(s)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{
+		String: v,
+		Valid:  true,
+	}, nil
+}
+
+// StringToDecodedJSON decodes JSON text s. Numbers are decoded as
+// json.Number to keep their precision. Empty string is decoded as NULL.
+func StringToDecodedJSON(s string) (JSON, error) {
+	if s == "" {
+		return JSON{}, nil
+	}
+
+	err := checkJSON(s)
+	if err != nil {
+		return JSON{}, err
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+	dec.UseNumber()
+	err = dec.Decode(&v)
+	if err != nil {
+		return JSON{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	return JSON{
+		Data:  v,
+		Valid: true,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStringToJSON(t *testing.T) {
+	for _, s := range []string{`{"a": [1, 2]}`, `"text"`, `null`, `12.50`} {
+		_, err := StringToJSON(s)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", s, err)
+		}
+	}
+
+	_, err := StringToJSON(`{"a": 1,}`)
+	if err == nil {
+		t.Fatal("must returns error")
+	}
+	if !strings.HasPrefix(err.Error(), "invalid JSON at offset 9") {
+		t.Errorf("different error: found=%s", err)
+	}
+}
+
+func TestStringToDecodedJSON(t *testing.T) {
+	j, err := StringToDecodedJSON(`{"price": 12.50, "tags": ["a"]}`)
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	if !j.Valid {
+		t.Fatal("must be valid")
+	}
+
+	v, err := j.Value()
+	if err != nil {
+		t.Fatalf("must not returns error: %s", err)
+	}
+	expected := `{"price":12.50,"tags":["a"]}`
+	if v != expected {
+		t.Errorf("different value: expected=%s found=%v", expected, v)
+	}
+
+	j, err = StringToDecodedJSON("")
+	if err != nil || j.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", j, err)
+	}
+}
@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+var (
+	numericPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+	moneyPattern   = regexp.MustCompile(`^\d{1,3}(,\d{3})*(\.\d*)?$|^\d*(\.\d*)?$`)
+	maxMoneyCents  = big.NewInt(9223372036854775807)
+	minMoneyCents  = big.NewInt(-9223372036854775808)
+	bigTen         = big.NewInt(10)
+)
+
+// maxNumericScale limits the number of fractional digits of unconstrained
+// numeric value.
+const maxNumericScale = 16383
+
+// StringToNumeric validates s as numeric value having precision and scale,
+// and returns it in canonical form. If precision is zero, any precision and
+// scale is allowed. Values are never rounded, so a value with more fractional
+// digits than scale is an error.
+func StringToNumeric(s string, precision, scale int) (string, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, "NaN") {
+		return "NaN", nil
+	}
+	if !numericPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid numeric value '%s'", s)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return "", fmt.Errorf("invalid numeric value '%s'", s)
+	}
+
+	if precision == 0 {
+		n, ok := fractionDigits(r, maxNumericScale)
+		if !ok {
+			return "", fmt.Errorf("numeric value '%s' has too many fractional digits", s)
+		}
+		return r.FloatString(n), nil
+	}
+
+	if _, ok := fractionDigits(r, scale); !ok {
+		return "", fmt.Errorf(
+			"numeric value '%s' has more than %d fractional digits", s, scale)
+	}
+
+	v := r.FloatString(scale)
+	intPart := strings.TrimLeft(strings.SplitN(strings.TrimPrefix(v, "-"), ".", 2)[0], "0")
+	if len(intPart) > precision-scale {
+		return "", fmt.Errorf(
+			"numeric value '%s' exceeds precision %d with scale %d", s, precision, scale)
+	}
+	return v, nil
+}
+
+// fractionDigits returns the minimum number of fractional digits needed to
+// write r exactly, but not more than max.
+func fractionDigits(r *big.Rat, max int) (int, bool) {
+	d := new(big.Int).Set(r.Denom())
+	n := 0
+	for d.Cmp(big.NewInt(1)) != 0 {
+		if n >= max {
+			return 0, false
+		}
+		// the denominator of a decimal value only has factors 2 and 5
+		g := new(big.Int).GCD(nil, nil, d, bigTen)
+		if g.Cmp(big.NewInt(1)) == 0 {
+			return 0, false
+		}
+		d.Quo(d, g)
+		n++
+	}
+	return n, true
+}
+
+// StringToNullNumeric converts string to numeric in sql.NullString. See
+// StringToNumeric.
+func StringToNullNumeric(s string, precision, scale int) (sql.NullString, error) {
+	if s == "" {
+		return sql.NullString{}, nil
+	}
+
+	v, err := StringToNumeric(s, precision, scale)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{
+		String: v,
+		Valid:  true,
+	}, nil
+}
+
+// StringToMoney validates s as money value and returns it in canonical form
+// with two fractional digits. The value may have a leading sign, a '$'
+// currency symbol and ',' group separators (e.g. -$1,234.5). Values are never
+// rounded, so a value with more than two fractional digits is an error.
+func StringToMoney(s string) (string, error) {
+	v := strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(v, "-") || strings.HasPrefix(v, "+") {
+		neg = v[0] == '-'
+		v = v[1:]
+	}
+	v = strings.TrimPrefix(v, "$")
+	if v == "" || v == "." || !moneyPattern.MatchString(v) {
+		return "", fmt.Errorf("invalid money value '%s'", s)
+	}
+	v = strings.ReplaceAll(v, ",", "")
+
+	r, ok := new(big.Rat).SetString(v)
+	if !ok {
+		return "", fmt.Errorf("invalid money value '%s'", s)
+	}
+	if neg {
+		r.Neg(r)
+	}
+
+	if _, ok := fractionDigits(r, 2); !ok {
+		return "", fmt.Errorf("money value '%s' has more than 2 fractional digits", s)
+	}
+
+	cents := new(big.Rat).Mul(r, big.NewRat(100, 1)).Num()
+	if cents.Cmp(maxMoneyCents) > 0 || cents.Cmp(minMoneyCents) < 0 {
+		return "", errors.New("money value out of range")
+	}
+	return r.FloatString(2), nil
+}
+
+// StringToNullMoney converts string to money in sql.NullString. See
+// StringToMoney.
+func StringToNullMoney(s string) (sql.NullString, error) {
+	if s == "" {
+		return sql.NullString{}, nil
+	}
+
+	v, err := StringToMoney(s)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{
+		String: v,
+		Valid:  true,
+	}, nil
+}
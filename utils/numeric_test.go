@@ -0,0 +1,90 @@
+package utils
+
+import "testing"
+
+func TestStringToNumeric(t *testing.T) {
+	cases := []struct {
+		s         string
+		precision int
+		scale     int
+		expected  string
+	}{
+		{"12.5", 0, 0, "12.5"},
+		{"1.5e3", 0, 0, "1500"},
+		{"-0.000000000000000000001", 0, 0, "-0.000000000000000000001"},
+		{" nan ", 0, 0, "NaN"},
+		{"123.4", 5, 2, "123.40"},
+		{"-999.99", 5, 2, "-999.99"},
+		{"1.50", 3, 1, "1.5"},
+		{".5", 2, 2, "0.50"},
+		{"+7", 1, 0, "7"},
+	}
+
+	for _, c := range cases {
+		found, err := StringToNumeric(c.s, c.precision, c.scale)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", c.s, err)
+			continue
+		}
+		if found != c.expected {
+			t.Errorf("different value for '%s': expected=%s found=%s",
+				c.s, c.expected, found)
+		}
+	}
+
+	errCases := []struct {
+		s         string
+		precision int
+		scale     int
+	}{
+		{"abc", 0, 0},
+		{"1/2", 0, 0},
+		{"1,000", 0, 0},
+		{"1000.00", 5, 2},
+		{"1.005", 5, 2},
+		{"12", 2, 2},
+	}
+
+	for _, c := range errCases {
+		_, err := StringToNumeric(c.s, c.precision, c.scale)
+		if err == nil {
+			t.Errorf("'%s' with numeric(%d,%d) must returns error",
+				c.s, c.precision, c.scale)
+		}
+	}
+}
+
+func TestStringToMoney(t *testing.T) {
+	cases := map[string]string{
+		"12":                    "12.00",
+		"$1,234.5":              "1234.50",
+		"-$0.99":                "-0.99",
+		"1234567.89":            "1234567.89",
+		"+.1":                   "0.10",
+		"-92233720368547758.08": "-92233720368547758.08",
+	}
+
+	for s, expected := range cases {
+		found, err := StringToMoney(s)
+		if err != nil {
+			t.Errorf("'%s' must not returns error: %s", s, err)
+			continue
+		}
+		if found != expected {
+			t.Errorf("different value for '%s': expected=%s found=%s",
+				s, expected, found)
+		}
+	}
+
+	for _, s := range []string{"", "$", "1.234", "12,34", "€5", "92233720368547758.08"} {
+		_, err := StringToMoney(s)
+		if err == nil {
+			t.Errorf("'%s' must returns error", s)
+		}
+	}
+
+	v, err := StringToNullMoney("")
+	if err != nil || v.Valid {
+		t.Errorf("empty value must be NULL, found=%v err=%v", v, err)
+	}
+}